@@ -0,0 +1,55 @@
+package collections
+
+import (
+	"strings"
+)
+
+// A collection that can be built from an iterator, based on Rust's FromIterator trait (https://doc.rust-lang.org/std/iter/trait.FromIterator.html)
+// Collect starts from the zero value of the collection and extends it with the contents of the iterator,
+// so the Extend method must also work on a zero valued collection (e.g. a nil map).
+type FromIterator[T any] interface {
+	Extend(it Iterator[T])
+}
+
+// Transforms an iterator into a collection.
+// The collection type is passed as the first type parameter, the rest are inferred:
+//
+//	vec := collections.Collect[collections.Vec[int]](it)
+//	set := collections.Collect[collections.Set[int]](it)
+//
+// NOTE: The pointer type parameter is needed because the Extend method of the collections has a pointer receiver.
+func Collect[C any, PC interface {
+	*C
+	FromIterator[T]
+}, T any](it Iterator[T]) C {
+	var collection C
+	PC(&collection).Extend(it)
+	return collection
+}
+
+// Transforms an iterator into a vector.
+func CollectVec[T any](it Iterator[T]) Vec[T] {
+	return Collect[Vec[T]](it)
+}
+
+// Transforms an iterator of key-value pairs into a map.
+// If the same key appears more than once, the last value is kept.
+func CollectMap[K comparable, V any](it Iterator[Pair[K, V]]) Map[K, V] {
+	return Collect[Map[K, V]](it)
+}
+
+// Transforms an iterator into a set.
+func CollectSet[T comparable](it Iterator[T]) Set[T] {
+	return Collect[Set[T]](it)
+}
+
+// Concatenates an iterator of runes or strings into a string.
+func CollectString[T ~rune | ~string](it Iterator[T]) string {
+	var builder strings.Builder
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		builder.WriteString(string(value.Unwrap()))
+	}
+
+	return builder.String()
+}
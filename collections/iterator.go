@@ -1,33 +1,150 @@
 package collections
 
-// A channel based iterator, used to abstarct a channel as an iterator.
-// Usage example (taken from collections.Vector[T]):
-// 	for value := range vec.Iter() {
+import (
+	"context"
+
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// A pull based iterator, modeled after Rust's Iterator trait (https://doc.rust-lang.org/std/iter/trait.Iterator.html)
+// Each call to Next advances the iterator and returns the next value, or None when the iteration is finished.
+// Usage example (taken from collections.Vec[T]):
+//
+//	it := vec.Iter()
+//	for value := it.Next(); value.IsSome(); value = it.Next() {
+//		fmt.Println(value.Unwrap())
+//	}
+//
+// Or, using Go's range-over-func (see ToSeq):
+//
+//	for value := range collections.ToSeq(vec.Iter()) {
 //		fmt.Println(value)
-// 	}
-type Iterator[T any] chan T
+//	}
+type Iterator[T any] interface {
+	Next() option.Option[T]
+}
 
-// Push a value into the channel, passing nil as the value will be ignored.
-func (ch *Iterator[T]) Push(value *T) {
-	if value == nil {
-		return
-	}
+// An iterator able to yield elements from both ends, based on Rust's DoubleEndedIterator trait (https://doc.rust-lang.org/std/iter/trait.DoubleEndedIterator.html)
+// Next and NextBack draw from the same range of elements, the iterator is finished when they meet in the middle.
+type DoubleEndedIterator[T any] interface {
+	Iterator[T]
 
-	*ch <- *value
+	// Removes and returns an element from the end of the iterator, or None when there are no more elements.
+	NextBack() option.Option[T]
 }
 
-// Close the channel, this function MUST be called after we are done use Push for all of the values we want to iterate.
-func (ch *Iterator[T]) Close() {
-	close(*ch)
+// An iterator that knows its exact length, based on Rust's ExactSizeIterator trait (https://doc.rust-lang.org/std/iter/trait.ExactSizeIterator.html)
+type ExactSizeIterator[T any] interface {
+	Iterator[T]
+
+	// Returns the exact remaining length of the iterator.
+	Len() int
 }
 
-// Convert an iterator into a vector of the same type.
-func (ch *Iterator[T]) IntoVector() *Vec[T] {
-	vec := Vec[T]{}
+// An iterator that can estimate its remaining length.
+// Implementing it is optional, use the SizeHint function to get the hint of any iterator.
+type SizeHinter interface {
+	// Returns the bounds on the remaining length of the iterator.
+	// The first value is the lower bound, the second is the upper bound, where None means there is no known upper bound.
+	SizeHint() (int, option.Option[int])
+}
 
-	for value := range *ch {
-		vec.Push(value)
+// Returns the bounds on the remaining length of any iterator.
+// The first value is the lower bound, the second is the upper bound, where None means there is no known upper bound.
+// Iterators implementing neither SizeHinter nor ExactSizeIterator get the default hint of (0, None).
+func SizeHint[T any](it Iterator[T]) (int, option.Option[int]) {
+	switch it := it.(type) {
+	case SizeHinter:
+		return it.SizeHint()
+	case ExactSizeIterator[T]:
+		return it.Len(), option.Some(it.Len())
+	default:
+		return 0, option.None[int]()
 	}
+}
+
+// An iterator over the elements of a vector, created by Vec.Iter.
+type VecIter[T any] struct {
+	vec   Vec[T]
+	front int
+	back  int
+}
+
+// Advances the iterator and returns the next value.
+func (it *VecIter[T]) Next() option.Option[T] {
+	if it.front >= it.back {
+		return option.None[T]()
+	}
+
+	value := it.vec[it.front]
+	it.front++
+	return option.Some(value)
+}
+
+// Removes and returns an element from the end of the iterator.
+func (it *VecIter[T]) NextBack() option.Option[T] {
+	if it.front >= it.back {
+		return option.None[T]()
+	}
+
+	it.back--
+	return option.Some(it.vec[it.back])
+}
+
+// Returns the exact remaining length of the iterator.
+func (it *VecIter[T]) Len() int {
+	return it.back - it.front
+}
+
+// Returns the bounds on the remaining length of the iterator, both are the exact length.
+func (it *VecIter[T]) SizeHint() (int, option.Option[int]) {
+	return it.Len(), option.Some(it.Len())
+}
 
+// Convert an iterator into a channel of the same type.
+// The values are pushed into the channel by a background goroutine, which only exits after the iterator is exhausted,
+// so the channel MUST be drained completely, otherwise the goroutine is leaked (use IntoChanContext to be able to stop it).
+func IntoChan[T any](it Iterator[T]) <-chan T {
+	ch := make(chan T)
+
+	go func() {
+		for value := it.Next(); value.IsSome(); value = it.Next() {
+			ch <- value.Unwrap()
+		}
+
+		close(ch)
+	}()
+
+	return ch
+}
+
+// Convert an iterator into a channel of the same type, like IntoChan.
+// The background goroutine also exits (and closes the channel) once the context is done, so there is no need to drain the channel after cancelling it.
+func IntoChanContext[T any](ctx context.Context, it Iterator[T]) <-chan T {
+	ch := make(chan T)
+
+	go func() {
+		defer close(ch)
+
+		for ctx.Err() == nil {
+			value := it.Next()
+			if value.IsNone() {
+				return
+			}
+
+			select {
+			case ch <- value.Unwrap():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Convert an iterator into a vector of the same type.
+func IntoVector[T any](it Iterator[T]) *Vec[T] {
+	vec := CollectVec(it)
 	return &vec
 }
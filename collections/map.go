@@ -1,8 +1,6 @@
 package collections
 
 import (
-	"fmt"
-
 	"github.com/avivatedgi/go-rust-std/option"
 )
 
@@ -23,8 +21,9 @@ func (m Map[K, V]) ContainsKey(key K) bool {
 
 // Clears the map, returning all key-value pairs as an iterator.
 func (m *Map[K, V]) Drain() Iterator[Pair[K, V]] {
-	defer m.Clear()
-	return m.Iter()
+	pairs := m.pairs()
+	m.Clear()
+	return pairs.Iter()
 }
 
 // Gets the given key’s corresponding entry in the map for in-place manipulation.
@@ -67,50 +66,55 @@ func (m *Map[K, V]) Insert(key K, value V) option.Option[V] {
 	return old
 }
 
-// Retreive all the keys of the map.
-func (m Map[K, _]) Keys() Iterator[K] {
-	ch := make(Iterator[K])
+// Extends the map with the key-value pairs of an iterator (first is key, second is value).
+// If a key from the iterator is already present in the map, its value is updated.
+// If the map is nil, it is allocated using the lower bound of the iterator's SizeHint.
+func (m *Map[K, V]) Extend(it Iterator[Pair[K, V]]) {
+	if *m == nil {
+		lower, _ := SizeHint(it)
+		*m = make(Map[K, V], lower)
+	}
 
-	go func() {
-		for k := range m {
-			fmt.Println("Pushing into keys", k)
-			ch.Push(&k)
-		}
+	for pair := it.Next(); pair.IsSome(); pair = it.Next() {
+		(*m)[pair.Unwrap().First] = pair.Unwrap().Second
+	}
+}
 
-		ch.Close()
-	}()
+// Retreive all the keys of the map.
+func (m Map[K, _]) Keys() Iterator[K] {
+	keys := make(Vec[K], 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
 
-	return ch
+	return keys.Iter()
 }
 
 // Retreive all the values of the map
 func (m Map[_, V]) Values() Iterator[V] {
-	ch := make(Iterator[V])
-
-	go func() {
-		for _, v := range m {
-			ch.Push(&v)
-		}
-
-		ch.Close()
-	}()
+	values := make(Vec[V], 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
 
-	return ch
+	return values.Iter()
 }
 
 // Return the map iterator.
+// The key-value pairs are collected when the iterator is created, so later changes to the map are not reflected by it.
 func (m Map[K, V]) Iter() Iterator[Pair[K, V]] {
-	ch := make(Iterator[Pair[K, V]])
-
-	go func() {
-		for k, v := range m {
-			ch.Push(&Pair[K, V]{First: k, Second: v})
-		}
+	pairs := m.pairs()
+	return pairs.Iter()
+}
 
-		ch.Close()
-	}()
+// Collects all the key-value pairs of the map into a vector.
+func (m Map[K, V]) pairs() Vec[Pair[K, V]] {
+	pairs := make(Vec[Pair[K, V]], 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, Pair[K, V]{First: k, Second: v})
+	}
 
-	return ch
+	return pairs
 }
 
 // Executes the f function once for each map entry.
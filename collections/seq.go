@@ -0,0 +1,120 @@
+package collections
+
+import (
+	"iter"
+
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// Bridges between the Iterator of this package and Go's range-over-func iterators (https://pkg.go.dev/iter)
+
+// Returns a range-over-func iterator over the elements of the vector.
+// Usage example:
+//
+//	for value := range vec.All() {
+//		fmt.Println(value)
+//	}
+func (vec Vec[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range vec {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Returns a range-over-func iterator over the key-value pairs of the map, in arbitrary order.
+func (m Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Returns a range-over-func iterator over the elements of the set, in arbitrary order.
+func (set Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range set {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Converts an iterator into a range-over-func iterator.
+// Breaking out of the loop early simply stops pulling values from the iterator, no resources are leaked.
+func ToSeq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := it.Next(); value.IsSome(); value = it.Next() {
+			if !yield(value.Unwrap()) {
+				return
+			}
+		}
+	}
+}
+
+// Converts an iterator of pairs into a range-over-func iterator of two values (first and second).
+func ToSeq2[K any, V any](it Iterator[Pair[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for pair := it.Next(); pair.IsSome(); pair = it.Next() {
+			if !yield(pair.Unwrap().First, pair.Unwrap().Second) {
+				return
+			}
+		}
+	}
+}
+
+// An iterator over a range-over-func iterator, created by FromSeq and FromSeq2.
+type SeqIter[T any] struct {
+	next func() option.Option[T]
+	stop func()
+}
+
+// Advances the iterator and returns the next value.
+func (it *SeqIter[T]) Next() option.Option[T] {
+	return it.next()
+}
+
+// Stops the underlying range-over-func iterator, after that Next always returns None.
+// Stop MUST be called if the iterator is not exhausted, otherwise the underlying iterator is leaked (see iter.Pull).
+// It is safe to call Stop multiple times, or after the iterator is exhausted.
+func (it *SeqIter[T]) Stop() {
+	it.stop()
+}
+
+// Converts a range-over-func iterator into an Iterator, using iter.Pull.
+func FromSeq[T any](seq iter.Seq[T]) *SeqIter[T] {
+	next, stop := iter.Pull(seq)
+
+	return &SeqIter[T]{
+		next: func() option.Option[T] {
+			if value, ok := next(); ok {
+				return option.Some(value)
+			}
+
+			return option.None[T]()
+		},
+		stop: stop,
+	}
+}
+
+// Converts a range-over-func iterator of two values into an Iterator of pairs (first and second), using iter.Pull2.
+func FromSeq2[K any, V any](seq iter.Seq2[K, V]) *SeqIter[Pair[K, V]] {
+	next, stop := iter.Pull2(seq)
+
+	return &SeqIter[Pair[K, V]]{
+		next: func() option.Option[Pair[K, V]] {
+			if k, v, ok := next(); ok {
+				return option.Some(Pair[K, V]{First: k, Second: v})
+			}
+
+			return option.None[Pair[K, V]]()
+		},
+		stop: stop,
+	}
+}
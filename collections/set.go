@@ -0,0 +1,78 @@
+package collections
+
+// A hash set implemented as a Map where the value is (), based on the one in Rust's standart library (https://doc.rust-lang.org/std/collections/struct.HashSet.html)
+type Set[T comparable] map[T]struct{}
+
+// Clears the set, removing all values.
+func (set *Set[T]) Clear() {
+	for value := range *set {
+		delete(*set, value)
+	}
+}
+
+// Returns true if the set contains a value.
+func (set Set[T]) Contains(value T) bool {
+	_, ok := set[value]
+	return ok
+}
+
+// Adds a value to the set.
+// Returns whether the value was newly inserted, that is:
+// If the set did not previously contain this value, true is returned.
+// If the set already contained this value, false is returned.
+func (set *Set[T]) Insert(value T) bool {
+	if *set == nil {
+		*set = make(Set[T])
+	}
+
+	if set.Contains(value) {
+		return false
+	}
+
+	(*set)[value] = struct{}{}
+	return true
+}
+
+// Removes a value from the set. Returns whether the value was present in the set.
+func (set *Set[T]) Remove(value T) bool {
+	if !set.Contains(value) {
+		return false
+	}
+
+	delete(*set, value)
+	return true
+}
+
+// Returns the number of elements in the set.
+func (set Set[T]) Len() int {
+	return len(set)
+}
+
+// Returns true if the set contains no elements.
+func (set Set[T]) IsEmpty() bool {
+	return set.Len() == 0
+}
+
+// Extends the set with the contents of an iterator.
+// If the set is nil, it is allocated using the lower bound of the iterator's SizeHint.
+func (set *Set[T]) Extend(it Iterator[T]) {
+	if *set == nil {
+		lower, _ := SizeHint(it)
+		*set = make(Set[T], lower)
+	}
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		set.Insert(value.Unwrap())
+	}
+}
+
+// Returns an iterator visiting all elements in arbitrary order.
+// The elements are collected when the iterator is created, so later changes to the set are not reflected by it.
+func (set Set[T]) Iter() Iterator[T] {
+	values := make(Vec[T], 0, len(set))
+	for value := range set {
+		values = append(values, value)
+	}
+
+	return values.Iter()
+}
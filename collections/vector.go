@@ -3,6 +3,7 @@ package collections
 import (
 	"fmt"
 
+	"github.com/avivatedgi/go-rust-std/num"
 	"github.com/avivatedgi/go-rust-std/option"
 )
 
@@ -73,14 +74,23 @@ func (vec *Vec[T]) DedupBy(f func(T, T) bool) {
 }
 
 // Removes the specified range from the vector in bulk, returning all removed elements as an Iterator.
-func (vec *Vec[T]) Drain(start, end int) Iterator[T] {
+func (vec *Vec[T]) Drain(start, end int) *VecIter[T] {
 	splice := vec.Splice(start, end, Vec[T]{})
 	return splice.Iter()
 }
 
-// Appends all elements in a slice to the Vec.
-func (vec *Vec[T]) Extend(other *Vec[T]) {
-	*vec = append(*vec, *other...)
+// Extends the vector with the contents of an iterator.
+// The lower bound of the iterator's SizeHint is used to reserve the needed capacity up front.
+func (vec *Vec[T]) Extend(it Iterator[T]) {
+	if lower, _ := SizeHint(it); lower > vec.Capacity()-vec.Len() {
+		grown := make(Vec[T], vec.Len(), vec.Len()+lower)
+		copy(grown, *vec)
+		*vec = grown
+	}
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		vec.Push(value.Unwrap())
+	}
 }
 
 // Inserts an element at position index within the vector, shifting all elements after it to the right.
@@ -235,16 +245,192 @@ func (vec *Vec[T]) Truncate(len int) {
 }
 
 // Returns an Iterator to the vector elements.
-func (vec *Vec[T]) Iter() Iterator[T] {
-	ch := make(Iterator[T])
+// The iterator is lazy and shares the backing array of the vector, so the elements are not copied.
+// Stopping early does not leak any resources.
+func (vec Vec[T]) Iter() *VecIter[T] {
+	return &VecIter[T]{vec: vec, back: vec.Len()}
+}
+
+// Sums the elements of the vector, an empty vector returns zero.
+//
+// NOTE: This function isn't a method of the vector because it can only work on numeric types.
+func Sum[T num.Number](vec Vec[T]) T {
+	var sum T
+	for _, item := range vec {
+		sum += item
+	}
+
+	return sum
+}
+
+// Multiplies the elements of the vector, an empty vector returns one.
+//
+// NOTE: This function isn't a method of the vector because it can only work on numeric types.
+func Product[T num.Number](vec Vec[T]) T {
+	product := T(1)
+	for _, item := range vec {
+		product *= item
+	}
+
+	return product
+}
+
+// Returns the minimum element of the vector, or None if it is empty.
+// If several elements are equally minimum, the first element is returned.
+//
+// NOTE: This function isn't a method of the vector because it can only work on ordered types.
+func Min[T num.Ordered](vec Vec[T]) option.Option[T] {
+	return vec.MinBy(num.Compare[T])
+}
+
+// Returns the maximum element of the vector, or None if it is empty.
+// If several elements are equally maximum, the last element is returned.
+//
+// NOTE: This function isn't a method of the vector because it can only work on ordered types.
+func Max[T num.Ordered](vec Vec[T]) option.Option[T] {
+	return vec.MaxBy(num.Compare[T])
+}
+
+// Returns both the minimum and the maximum elements of the vector in a single pass, as a Pair (first is min, second is max).
+// The same tie breaking rules of Min and Max apply, if the vector is empty, None is returned.
+//
+// NOTE: This function isn't a method of the vector because it can only work on ordered types.
+func MinMax[T num.Ordered](vec Vec[T]) option.Option[Pair[T, T]] {
+	if vec.IsEmpty() {
+		return option.None[Pair[T, T]]()
+	}
+
+	pair := Pair[T, T]{First: vec[0], Second: vec[0]}
+	for _, item := range vec[1:] {
+		if num.Compare(item, pair.First) < 0 {
+			pair.First = item
+		} else if num.Compare(item, pair.Second) >= 0 {
+			pair.Second = item
+		}
+	}
+
+	return option.Some(pair)
+}
+
+// Returns the element that gives the minimum value from the specified function, or None if the vector is empty.
+// The key function is called exactly once per element, if several elements are equally minimum, the first element is returned.
+//
+// NOTE: This function isn't a method of the vector because methods must have no type parameters.
+func MinByKey[T any, K num.Ordered](vec Vec[T], key func(T) K) option.Option[T] {
+	if vec.IsEmpty() {
+		return option.None[T]()
+	}
+
+	min, minKey := vec[0], key(vec[0])
+	for _, item := range vec[1:] {
+		if k := key(item); num.Compare(k, minKey) < 0 {
+			min, minKey = item, k
+		}
+	}
+
+	return option.Some(min)
+}
+
+// Returns the element that gives the maximum value from the specified function, or None if the vector is empty.
+// The key function is called exactly once per element, if several elements are equally maximum, the last element is returned.
+//
+// NOTE: This function isn't a method of the vector because methods must have no type parameters.
+func MaxByKey[T any, K num.Ordered](vec Vec[T], key func(T) K) option.Option[T] {
+	if vec.IsEmpty() {
+		return option.None[T]()
+	}
+
+	max, maxKey := vec[0], key(vec[0])
+	for _, item := range vec[1:] {
+		if k := key(item); num.Compare(k, maxKey) >= 0 {
+			max, maxKey = item, k
+		}
+	}
+
+	return option.Some(max)
+}
+
+// Returns the element that gives the minimum value with respect to the specified comparison function, or None if the vector is empty.
+// The comparison function returns a negative number if a < b, zero if a == b and a positive number if a > b.
+// If several elements are equally minimum, the first element is returned.
+func (vec Vec[T]) MinBy(compare func(a, b T) int) option.Option[T] {
+	if vec.IsEmpty() {
+		return option.None[T]()
+	}
+
+	min := vec[0]
+	for _, item := range vec[1:] {
+		if compare(item, min) < 0 {
+			min = item
+		}
+	}
+
+	return option.Some(min)
+}
 
-	go func() {
-		for _, item := range *vec {
-			ch.Push(&item)
+// Returns the element that gives the maximum value with respect to the specified comparison function, or None if the vector is empty.
+// The comparison function returns a negative number if a < b, zero if a == b and a positive number if a > b.
+// If several elements are equally maximum, the last element is returned.
+func (vec Vec[T]) MaxBy(compare func(a, b T) int) option.Option[T] {
+	if vec.IsEmpty() {
+		return option.None[T]()
+	}
+
+	max := vec[0]
+	for _, item := range vec[1:] {
+		if compare(item, max) >= 0 {
+			max = item
 		}
+	}
 
-		ch.Close()
-	}()
+	return option.Some(max)
+}
+
+// Checks if the elements of the vector are sorted (in non-descending order).
+// Like in rust, a vector containing a floating-point NaN is not sorted.
+//
+// NOTE: This function isn't a method of the vector because it can only work on ordered types.
+func IsSorted[T num.Ordered](vec Vec[T]) bool {
+	for i := 1; i < vec.Len(); i++ {
+		// Comparisons with NaN are always false, so it is never <= its neighbours
+		if !(vec[i-1] <= vec[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Checks if the elements of the vector are sorted using the given key extraction function.
+// The key function is called exactly once per element.
+//
+// NOTE: This function isn't a method of the vector because methods must have no type parameters.
+func IsSortedByKey[T any, K num.Ordered](vec Vec[T], key func(T) K) bool {
+	if vec.IsEmpty() {
+		return true
+	}
+
+	previous := key(vec[0])
+	for _, item := range vec[1:] {
+		current := key(item)
+		if !(previous <= current) {
+			return false
+		}
+
+		previous = current
+	}
+
+	return true
+}
+
+// Checks if the elements of the vector are sorted using the given comparison function.
+// The comparison function returns a negative number if a < b, zero if a == b and a positive number if a > b.
+func (vec Vec[T]) IsSortedBy(compare func(a, b T) int) bool {
+	for i := 1; i < vec.Len(); i++ {
+		if compare(vec[i-1], vec[i]) > 0 {
+			return false
+		}
+	}
 
-	return ch
+	return true
 }
@@ -1,3 +1,3 @@
 module github.com/avivatedgi/go-rust-std
 
-go 1.18
+go 1.24
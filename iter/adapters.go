@@ -0,0 +1,296 @@
+package iter
+
+import (
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/option"
+	"github.com/avivatedgi/go-rust-std/result"
+)
+
+// The adapters in this file are based on the ones in the Rust's standart library (https://doc.rust-lang.org/std/iter/trait.Iterator.html)
+// All of them are lazy, they do nothing until the returned iterator is advanced.
+// They are not methods of the iterator because methods must have no type parameters.
+// https://github.com/golang/go/issues/48793
+
+type mapIter[T any, U any] struct {
+	it collections.Iterator[T]
+	f  func(T) U
+}
+
+// Takes a closure and creates an iterator which calls that closure on each element.
+func Map[T any, U any](it collections.Iterator[T], f func(T) U) collections.Iterator[U] {
+	return &mapIter[T, U]{it: it, f: f}
+}
+
+func (m *mapIter[T, U]) Next() option.Option[U] {
+	value := m.it.Next()
+	if value.IsNone() {
+		return option.None[U]()
+	}
+
+	return option.Some(m.f(value.Unwrap()))
+}
+
+func (m *mapIter[T, U]) SizeHint() (int, option.Option[int]) {
+	return collections.SizeHint(m.it)
+}
+
+type filterIter[T any] struct {
+	it collections.Iterator[T]
+	f  func(T) bool
+}
+
+// Creates an iterator which uses a closure to determine if an element should be yielded.
+// Only the elements for which the closure returns true will be yielded.
+func Filter[T any](it collections.Iterator[T], f func(T) bool) collections.Iterator[T] {
+	return &filterIter[T]{it: it, f: f}
+}
+
+func (f *filterIter[T]) Next() option.Option[T] {
+	for value := f.it.Next(); value.IsSome(); value = f.it.Next() {
+		if f.f(value.Unwrap()) {
+			return value
+		}
+	}
+
+	return option.None[T]()
+}
+
+func (f *filterIter[T]) SizeHint() (int, option.Option[int]) {
+	return upperOnly(collections.SizeHint(f.it))
+}
+
+type filterMapIter[T any, U any] struct {
+	it collections.Iterator[T]
+	f  func(T) option.Option[U]
+}
+
+// Creates an iterator that both filters and maps.
+// The returned iterator yields only the values for which the supplied closure returns Some(value).
+func FilterMap[T any, U any](it collections.Iterator[T], f func(T) option.Option[U]) collections.Iterator[U] {
+	return &filterMapIter[T, U]{it: it, f: f}
+}
+
+func (f *filterMapIter[T, U]) Next() option.Option[U] {
+	for value := f.it.Next(); value.IsSome(); value = f.it.Next() {
+		if mapped := f.f(value.Unwrap()); mapped.IsSome() {
+			return mapped
+		}
+	}
+
+	return option.None[U]()
+}
+
+func (f *filterMapIter[T, U]) SizeHint() (int, option.Option[int]) {
+	return upperOnly(collections.SizeHint(f.it))
+}
+
+type flattenIter[T any] struct {
+	it      collections.Iterator[collections.Iterator[T]]
+	current collections.Iterator[T]
+}
+
+// Creates an iterator that flattens nested structure.
+// This is useful when you have an iterator of iterators and you want to remove one level of indirection.
+func Flatten[T any](it collections.Iterator[collections.Iterator[T]]) collections.Iterator[T] {
+	return &flattenIter[T]{it: it}
+}
+
+func (f *flattenIter[T]) Next() option.Option[T] {
+	for {
+		if f.current != nil {
+			if value := f.current.Next(); value.IsSome() {
+				return value
+			}
+		}
+
+		inner := f.it.Next()
+		if inner.IsNone() {
+			f.current = nil
+			return option.None[T]()
+		}
+
+		f.current = inner.Unwrap()
+	}
+}
+
+// Creates an iterator that works like Map, but flattens nested structure.
+// Each element is mapped into an iterator by the closure, and the elements of those iterators are yielded one after the other.
+func FlatMap[T any, U any](it collections.Iterator[T], f func(T) collections.Iterator[U]) collections.Iterator[U] {
+	return Flatten(Map(it, f))
+}
+
+// Creates an iterator over the values of the Some elements, skipping the None ones.
+//
+// NOTE: In rust this is a case of Flatten, as Option is iterable, here it is a separate function as Go doesn't allow overloading.
+func FlattenOption[T any](it collections.Iterator[option.Option[T]]) collections.Iterator[T] {
+	return FilterMap(it, func(value option.Option[T]) option.Option[T] { return value })
+}
+
+// Creates an iterator over the values of the Ok elements, skipping the Err ones.
+//
+// NOTE: In rust this is a case of Flatten, as Result is iterable, here it is a separate function as Go doesn't allow overloading.
+func FlattenResult[T any, E error](it collections.Iterator[result.Result[T, E]]) collections.Iterator[T] {
+	return FilterMap(it, func(value result.Result[T, E]) option.Option[T] { return value.Ok() })
+}
+
+type enumerateIter[T any] struct {
+	it    collections.Iterator[T]
+	count int
+}
+
+// Creates an iterator which gives the current iteration count as well as the next value.
+// The iterator yields pairs (i, value), where i is the current index of iteration and value is the value returned by the iterator.
+func Enumerate[T any](it collections.Iterator[T]) collections.Iterator[collections.Pair[int, T]] {
+	return &enumerateIter[T]{it: it}
+}
+
+func (e *enumerateIter[T]) Next() option.Option[collections.Pair[int, T]] {
+	value := e.it.Next()
+	if value.IsNone() {
+		return option.None[collections.Pair[int, T]]()
+	}
+
+	pair := collections.Pair[int, T]{First: e.count, Second: value.Unwrap()}
+	e.count++
+	return option.Some(pair)
+}
+
+func (e *enumerateIter[T]) SizeHint() (int, option.Option[int]) {
+	return collections.SizeHint(e.it)
+}
+
+type zipIter[T any, U any] struct {
+	a collections.Iterator[T]
+	b collections.Iterator[U]
+}
+
+// ‘Zips up’ two iterators into a single iterator of pairs.
+// If either iterator returns None, Next from the zipped iterator will return None.
+// If the first iterator returns None, the second iterator will not be advanced.
+func Zip[T any, U any](a collections.Iterator[T], b collections.Iterator[U]) collections.Iterator[collections.Pair[T, U]] {
+	return &zipIter[T, U]{a: a, b: b}
+}
+
+func (z *zipIter[T, U]) Next() option.Option[collections.Pair[T, U]] {
+	first := z.a.Next()
+	if first.IsNone() {
+		return option.None[collections.Pair[T, U]]()
+	}
+
+	second := z.b.Next()
+	if second.IsNone() {
+		return option.None[collections.Pair[T, U]]()
+	}
+
+	return option.Some(collections.Pair[T, U]{First: first.Unwrap(), Second: second.Unwrap()})
+}
+
+func (z *zipIter[T, U]) SizeHint() (int, option.Option[int]) {
+	aLower, aUpper := collections.SizeHint(z.a)
+	bLower, bUpper := collections.SizeHint(z.b)
+
+	if aLower > bLower {
+		aLower = bLower
+	}
+
+	return aLower, minUpper(aUpper, bUpper)
+}
+
+type chainIter[T any] struct {
+	a collections.Iterator[T]
+	b collections.Iterator[T]
+}
+
+// Takes two iterators and creates a new iterator over both in sequence.
+// The returned iterator will first iterate over values from the first iterator and then over values from the second iterator.
+func Chain[T any](a collections.Iterator[T], b collections.Iterator[T]) collections.Iterator[T] {
+	return &chainIter[T]{a: a, b: b}
+}
+
+func (c *chainIter[T]) Next() option.Option[T] {
+	if c.a != nil {
+		if value := c.a.Next(); value.IsSome() {
+			return value
+		}
+
+		// The first iterator is exhausted, release it
+		c.a = nil
+	}
+
+	return c.b.Next()
+}
+
+func (c *chainIter[T]) SizeHint() (int, option.Option[int]) {
+	if c.a == nil {
+		return collections.SizeHint(c.b)
+	}
+
+	aLower, aUpper := collections.SizeHint(c.a)
+	bLower, bUpper := collections.SizeHint(c.b)
+	return sumHints(aLower, aUpper, bLower, bUpper)
+}
+
+type inspectIter[T any] struct {
+	it collections.Iterator[T]
+	f  func(T)
+}
+
+// Does something with each element of an iterator, passing the value on.
+// This is useful for debugging, or for side effects such as logging in the middle of a pipeline.
+func Inspect[T any](it collections.Iterator[T], f func(T)) collections.Iterator[T] {
+	return &inspectIter[T]{it: it, f: f}
+}
+
+func (i *inspectIter[T]) Next() option.Option[T] {
+	value := i.it.Next()
+	if value.IsSome() {
+		i.f(value.Unwrap())
+	}
+
+	return value
+}
+
+func (i *inspectIter[T]) SizeHint() (int, option.Option[int]) {
+	return collections.SizeHint(i.it)
+}
+
+type scanIter[T any, S any, U any] struct {
+	it    collections.Iterator[T]
+	state S
+	f     func(*S, T) option.Option[U]
+	done  bool
+}
+
+// An iterator adapter which holds internal state while producing a new iterator.
+// The closure is passed a pointer to the state (which starts as initial) and the next element of the iterator.
+// The iterator yields the values returned by the closure, and stops the first time the closure returns None.
+func Scan[T any, S any, U any](it collections.Iterator[T], initial S, f func(*S, T) option.Option[U]) collections.Iterator[U] {
+	return &scanIter[T, S, U]{it: it, state: initial, f: f}
+}
+
+func (s *scanIter[T, S, U]) Next() option.Option[U] {
+	if s.done {
+		return option.None[U]()
+	}
+
+	value := s.it.Next()
+	if value.IsNone() {
+		s.done = true
+		return option.None[U]()
+	}
+
+	mapped := s.f(&s.state, value.Unwrap())
+	if mapped.IsNone() {
+		s.done = true
+	}
+
+	return mapped
+}
+
+func (s *scanIter[T, S, U]) SizeHint() (int, option.Option[int]) {
+	if s.done {
+		return 0, option.Some(0)
+	}
+
+	return upperOnly(collections.SizeHint(s.it))
+}
@@ -0,0 +1,93 @@
+package iter
+
+import (
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/num"
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// Sums the elements of an iterator, an empty iterator returns zero.
+func Sum[T num.Number](it collections.Iterator[T]) T {
+	return Fold(it, T(0), func(sum T, value T) T { return sum + value })
+}
+
+// Iterates over the entire iterator, multiplying all the elements, an empty iterator returns one.
+func Product[T num.Number](it collections.Iterator[T]) T {
+	return Fold(it, T(1), func(product T, value T) T { return product * value })
+}
+
+// Returns the minimum element of an iterator.
+// If several elements are equally minimum, the first element is returned, if the iterator is empty, None is returned.
+func Min[T num.Ordered](it collections.Iterator[T]) option.Option[T] {
+	return MinBy(it, num.Compare[T])
+}
+
+// Returns the maximum element of an iterator.
+// If several elements are equally maximum, the last element is returned, if the iterator is empty, None is returned.
+func Max[T num.Ordered](it collections.Iterator[T]) option.Option[T] {
+	return MaxBy(it, num.Compare[T])
+}
+
+// Returns the element that gives the minimum value with respect to the specified comparison function.
+// The comparison function returns a negative number if a < b, zero if a == b and a positive number if a > b.
+// If several elements are equally minimum, the first element is returned, if the iterator is empty, None is returned.
+func MinBy[T any](it collections.Iterator[T], compare func(a, b T) int) option.Option[T] {
+	return Reduce(it, func(min T, value T) T {
+		if compare(value, min) < 0 {
+			return value
+		}
+
+		return min
+	})
+}
+
+// Returns the element that gives the maximum value with respect to the specified comparison function.
+// The comparison function returns a negative number if a < b, zero if a == b and a positive number if a > b.
+// If several elements are equally maximum, the last element is returned, if the iterator is empty, None is returned.
+func MaxBy[T any](it collections.Iterator[T], compare func(a, b T) int) option.Option[T] {
+	return Reduce(it, func(max T, value T) T {
+		if compare(value, max) >= 0 {
+			return value
+		}
+
+		return max
+	})
+}
+
+// Returns the element that gives the minimum value from the specified function.
+// The key function is called exactly once per element.
+// If several elements are equally minimum, the first element is returned, if the iterator is empty, None is returned.
+func MinByKey[T any, K num.Ordered](it collections.Iterator[T], key func(T) K) option.Option[T] {
+	keyed := Map(it, func(value T) collections.Pair[K, T] { return collections.Pair[K, T]{First: key(value), Second: value} })
+	min := MinBy(keyed, func(a, b collections.Pair[K, T]) int { return num.Compare(a.First, b.First) })
+	return option.Map(min, func(pair *collections.Pair[K, T]) T { return pair.Second })
+}
+
+// Returns the element that gives the maximum value from the specified function.
+// The key function is called exactly once per element.
+// If several elements are equally maximum, the last element is returned, if the iterator is empty, None is returned.
+func MaxByKey[T any, K num.Ordered](it collections.Iterator[T], key func(T) K) option.Option[T] {
+	keyed := Map(it, func(value T) collections.Pair[K, T] { return collections.Pair[K, T]{First: key(value), Second: value} })
+	max := MaxBy(keyed, func(a, b collections.Pair[K, T]) int { return num.Compare(a.First, b.First) })
+	return option.Map(max, func(pair *collections.Pair[K, T]) T { return pair.Second })
+}
+
+// Returns both the minimum and the maximum elements of an iterator in a single pass, as a Pair (first is min, second is max).
+// The same tie breaking rules of Min and Max apply, if the iterator is empty, None is returned.
+func MinMax[T num.Ordered](it collections.Iterator[T]) option.Option[collections.Pair[T, T]] {
+	first := it.Next()
+	if first.IsNone() {
+		return option.None[collections.Pair[T, T]]()
+	}
+
+	pair := collections.Pair[T, T]{First: first.Unwrap(), Second: first.Unwrap()}
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		if v := value.Unwrap(); num.Compare(v, pair.First) < 0 {
+			pair.First = v
+		} else if num.Compare(v, pair.Second) >= 0 {
+			pair.Second = v
+		}
+	}
+
+	return option.Some(pair)
+}
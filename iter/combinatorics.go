@@ -0,0 +1,242 @@
+package iter
+
+import (
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// The iterators in this file are lazy, they only keep the indices of the current output,
+// so the (possibly exponential) output is never computed up front.
+// Every output is a new vector, so it can be kept after the iterator is advanced.
+
+// Returns a new vector holding the elements of vec at the given indices.
+func pick[T any](vec collections.Vec[T], indices []int) collections.Vec[T] {
+	picked := make(collections.Vec[T], len(indices))
+	for i, index := range indices {
+		picked[i] = vec[index]
+	}
+
+	return picked
+}
+
+type cartesianProductIter[T any] struct {
+	vecs    []collections.Vec[T]
+	indices []int
+	started bool
+	done    bool
+}
+
+// Creates an iterator over the cartesian product of the vectors, every output holds one element of each vector, in order.
+// The outputs are yielded in lexicographic order of the indices, so the last vector changes the fastest.
+// If any of the vectors is empty, the iterator yields nothing, if no vectors are given, it yields a single empty vector.
+func CartesianProduct[T any](vecs ...collections.Vec[T]) collections.Iterator[collections.Vec[T]] {
+	return &cartesianProductIter[T]{vecs: vecs, indices: make([]int, len(vecs))}
+}
+
+func (c *cartesianProductIter[T]) Next() option.Option[collections.Vec[T]] {
+	if c.done {
+		return option.None[collections.Vec[T]]()
+	}
+
+	if !c.started {
+		c.started = true
+		for _, vec := range c.vecs {
+			if vec.IsEmpty() {
+				c.done = true
+				return option.None[collections.Vec[T]]()
+			}
+		}
+	} else if !c.advance() {
+		c.done = true
+		return option.None[collections.Vec[T]]()
+	}
+
+	product := make(collections.Vec[T], len(c.vecs))
+	for i, index := range c.indices {
+		product[i] = c.vecs[i][index]
+	}
+
+	return option.Some(product)
+}
+
+// Advances the indices like an odometer, returns false after the last output.
+func (c *cartesianProductIter[T]) advance() bool {
+	for i := len(c.indices) - 1; i >= 0; i-- {
+		c.indices[i]++
+		if c.indices[i] < c.vecs[i].Len() {
+			return true
+		}
+
+		c.indices[i] = 0
+	}
+
+	return false
+}
+
+type combinationsIter[T any] struct {
+	vec     collections.Vec[T]
+	indices []int
+	started bool
+	done    bool
+}
+
+// Creates an iterator over all the k-length combinations of the elements of the vector.
+// The combinations are yielded in lexicographic order of the indices, and the elements keep their original order inside them.
+// Elements are treated as unique based on their position, not on their value.
+// If k is greater than the length of the vector, the iterator yields nothing.
+func Combinations[T any](vec collections.Vec[T], k int) collections.Iterator[collections.Vec[T]] {
+	indices := make([]int, max(k, 0))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	return &combinationsIter[T]{vec: vec, indices: indices, done: k < 0 || k > vec.Len()}
+}
+
+func (c *combinationsIter[T]) Next() option.Option[collections.Vec[T]] {
+	if c.done {
+		return option.None[collections.Vec[T]]()
+	}
+
+	if !c.started {
+		c.started = true
+		return option.Some(pick(c.vec, c.indices))
+	}
+
+	// Find the rightmost index that can still be incremented
+	n, k := c.vec.Len(), len(c.indices)
+	i := k - 1
+	for ; i >= 0 && c.indices[i] == i+n-k; i-- {
+	}
+
+	if i < 0 {
+		c.done = true
+		return option.None[collections.Vec[T]]()
+	}
+
+	c.indices[i]++
+	for j := i + 1; j < k; j++ {
+		c.indices[j] = c.indices[j-1] + 1
+	}
+
+	return option.Some(pick(c.vec, c.indices))
+}
+
+type combinationsWithReplacementIter[T any] struct {
+	vec     collections.Vec[T]
+	indices []int
+	started bool
+	done    bool
+}
+
+// Creates an iterator over all the k-length combinations of the elements of the vector, allowing elements to be repeated.
+// The combinations are yielded in lexicographic order of the indices, and the elements keep their original order inside them.
+// If the vector is empty (and k is positive), the iterator yields nothing.
+func CombinationsWithReplacement[T any](vec collections.Vec[T], k int) collections.Iterator[collections.Vec[T]] {
+	return &combinationsWithReplacementIter[T]{vec: vec, indices: make([]int, max(k, 0)), done: k < 0 || (k > 0 && vec.IsEmpty())}
+}
+
+func (c *combinationsWithReplacementIter[T]) Next() option.Option[collections.Vec[T]] {
+	if c.done {
+		return option.None[collections.Vec[T]]()
+	}
+
+	if !c.started {
+		c.started = true
+		return option.Some(pick(c.vec, c.indices))
+	}
+
+	// Find the rightmost index that can still be incremented
+	n, k := c.vec.Len(), len(c.indices)
+	i := k - 1
+	for ; i >= 0 && c.indices[i] == n-1; i-- {
+	}
+
+	if i < 0 {
+		c.done = true
+		return option.None[collections.Vec[T]]()
+	}
+
+	value := c.indices[i] + 1
+	for j := i; j < k; j++ {
+		c.indices[j] = value
+	}
+
+	return option.Some(pick(c.vec, c.indices))
+}
+
+type permutationsIter[T any] struct {
+	vec     collections.Vec[T]
+	k       int
+	indices []int
+	cycles  []int
+	started bool
+	done    bool
+}
+
+// Creates an iterator over all the k-length permutations of the elements of the vector.
+// The permutations are yielded in lexicographic order of the indices.
+// Elements are treated as unique based on their position, not on their value.
+// If k is greater than the length of the vector, the iterator yields nothing.
+func Permutations[T any](vec collections.Vec[T], k int) collections.Iterator[collections.Vec[T]] {
+	n := vec.Len()
+	if k < 0 || k > n {
+		return &permutationsIter[T]{done: true}
+	}
+
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+
+	cycles := make([]int, k)
+	for i := range cycles {
+		cycles[i] = n - i
+	}
+
+	return &permutationsIter[T]{vec: vec, k: k, indices: indices, cycles: cycles}
+}
+
+func (p *permutationsIter[T]) Next() option.Option[collections.Vec[T]] {
+	if p.done {
+		return option.None[collections.Vec[T]]()
+	}
+
+	if !p.started {
+		p.started = true
+		return option.Some(pick(p.vec, p.indices[:p.k]))
+	}
+
+	// This is the algorithm used by Python's itertools.permutations
+	n := p.vec.Len()
+	for i := p.k - 1; i >= 0; i-- {
+		p.cycles[i]--
+
+		if p.cycles[i] == 0 {
+			// Rotate the index at i to the end
+			first := p.indices[i]
+			copy(p.indices[i:], p.indices[i+1:])
+			p.indices[n-1] = first
+			p.cycles[i] = n - i
+			continue
+		}
+
+		j := n - p.cycles[i]
+		p.indices[i], p.indices[j] = p.indices[j], p.indices[i]
+		return option.Some(pick(p.vec, p.indices[:p.k]))
+	}
+
+	p.done = true
+	return option.None[collections.Vec[T]]()
+}
+
+// Creates an iterator over all the subsets of the elements of the vector, ordered by their length.
+// The subsets of every length are yielded in the order of Combinations, starting with the empty subset.
+func Powerset[T any](vec collections.Vec[T]) collections.Iterator[collections.Vec[T]] {
+	lengths := make(collections.Vec[int], vec.Len()+1)
+	for i := range lengths {
+		lengths[i] = i
+	}
+
+	return FlatMap[int](lengths.Iter(), func(k int) collections.Iterator[collections.Vec[T]] { return Combinations(vec, k) })
+}
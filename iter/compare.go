@@ -0,0 +1,123 @@
+package iter
+
+import (
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/num"
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// The functions in this file compare iterators element by element, they stop as soon as the answer is known.
+
+// Determines if the elements of two iterators are equal, and both have the same length.
+func Equal[T comparable](a collections.Iterator[T], b collections.Iterator[T]) bool {
+	return EqualBy(a, b, func(a, b T) bool { return a == b })
+}
+
+// Determines if the elements of two iterators are equal with respect to the specified equality function, and both have the same length.
+func EqualBy[T any, U any](a collections.Iterator[T], b collections.Iterator[U], eq func(T, U) bool) bool {
+	return CmpBy(a, b, func(a T, b U) int {
+		if eq(a, b) {
+			return 0
+		}
+
+		// Any non zero value will do, the elements are only checked for equality
+		return 1
+	}).IsEq()
+}
+
+// Lexicographically compares the elements of two iterators.
+// The first pair of elements that are not equal determines the result, if one iterator is a prefix of the other, the shorter one is Less.
+// The elements are compared with num.Compare, which is a total order: a floating-point NaN is equal to NaN and less than any other value,
+// so Cmp may report Equal where Equal (which uses ==) reports false, use PartialCmp to treat NaN as incomparable.
+func Cmp[T num.Ordered](a collections.Iterator[T], b collections.Iterator[T]) num.Ordering {
+	return CmpBy(a, b, num.Compare[T])
+}
+
+// Lexicographically compares the elements of two iterators with respect to the specified comparison function.
+// The comparison function returns a negative number if a < b, zero if a == b and a positive number if a > b.
+func CmpBy[T any, U any](a collections.Iterator[T], b collections.Iterator[U], compare func(T, U) int) num.Ordering {
+	for {
+		first, second := a.Next(), b.Next()
+
+		switch {
+		case first.IsNone() && second.IsNone():
+			return num.Equal
+		case first.IsNone():
+			return num.Less
+		case second.IsNone():
+			return num.Greater
+		}
+
+		if ordering := num.OrderingOf(compare(first.Unwrap(), second.Unwrap())); ordering.IsNe() {
+			return ordering
+		}
+	}
+}
+
+// Lexicographically compares the elements of two iterators, like Cmp, but the elements may be incomparable.
+// Returns None if a pair of incomparable elements (a floating-point NaN) is reached before the result is known.
+func PartialCmp[T num.Ordered](a collections.Iterator[T], b collections.Iterator[T]) option.Option[num.Ordering] {
+	incomparable := false
+	ordering := CmpBy(a, b, func(a, b T) int {
+		// NaN is the only value that is not equal to itself
+		if a != a || b != b {
+			incomparable = true
+			return 1
+		}
+
+		return num.Compare(a, b)
+	})
+
+	if incomparable {
+		return option.None[num.Ordering]()
+	}
+
+	return option.Some(ordering)
+}
+
+// Checks if the elements of the iterator are sorted (in non-descending order).
+// An empty iterator, or an iterator with a single element, is sorted. Like in rust, an iterator containing a floating-point NaN is not.
+// IsSorted is short-circuiting, it will stop processing at the first pair of elements that is out of order.
+func IsSorted[T num.Ordered](it collections.Iterator[T]) bool {
+	previous := it.Next()
+	if previous.IsNone() {
+		return true
+	}
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		// Comparisons with NaN are always false, so it is never <= its neighbours
+		if !(previous.Unwrap() <= value.Unwrap()) {
+			return false
+		}
+
+		previous = value
+	}
+
+	return true
+}
+
+// Checks if the elements of the iterator are sorted using the given comparison function.
+// The comparison function returns a negative number if a < b, zero if a == b and a positive number if a > b.
+// IsSortedBy is short-circuiting, it will stop processing at the first pair of elements that is out of order.
+func IsSortedBy[T any](it collections.Iterator[T], compare func(a, b T) int) bool {
+	previous := it.Next()
+	if previous.IsNone() {
+		return true
+	}
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		if compare(previous.Unwrap(), value.Unwrap()) > 0 {
+			return false
+		}
+
+		previous = value
+	}
+
+	return true
+}
+
+// Checks if the elements of the iterator are sorted using the given key extraction function.
+// The key function is called exactly once per element.
+func IsSortedByKey[T any, K num.Ordered](it collections.Iterator[T], key func(T) K) bool {
+	return IsSorted(Map(it, key))
+}
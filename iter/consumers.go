@@ -0,0 +1,134 @@
+package iter
+
+import (
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// The functions in this file consume the iterator they are given.
+// The short-circuiting ones stop as soon as they have an answer, leaving the rest of the elements in the iterator.
+
+// Folds every element into an accumulator by applying an operation, returning the final result.
+// Fold takes two arguments: an initial value, and a closure with two arguments: an ‘accumulator’, and an element.
+// The closure returns the value that the accumulator should have for the next iteration.
+func Fold[T any, B any](it collections.Iterator[T], initial B, f func(B, T) B) B {
+	accumulator := initial
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		accumulator = f(accumulator, value.Unwrap())
+	}
+
+	return accumulator
+}
+
+// Reduces the elements to a single one, by repeatedly applying a reducing operation.
+// If the iterator is empty, returns None, otherwise, returns the result of the reduction.
+func Reduce[T any](it collections.Iterator[T], f func(T, T) T) option.Option[T] {
+	first := it.Next()
+	if first.IsNone() {
+		return first
+	}
+
+	return option.Some(Fold(it, first.Unwrap(), f))
+}
+
+// Consumes the iterator, counting the number of iterations and returning it.
+func Count[T any](it collections.Iterator[T]) int {
+	return Fold(it, 0, func(count int, _ T) int { return count + 1 })
+}
+
+// Consumes the iterator, returning the last element, or None if it is empty.
+func Last[T any](it collections.Iterator[T]) option.Option[T] {
+	last := option.None[T]()
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		last = value
+	}
+
+	return last
+}
+
+// Returns the nth element of the iterator (zero based), or None if n is greater than or equal to the length of the iterator.
+// All the preceding elements, as well as the returned element, are consumed from the iterator.
+func Nth[T any](it collections.Iterator[T], n int) option.Option[T] {
+	if n < 0 {
+		return option.None[T]()
+	}
+
+	for ; n > 0; n-- {
+		if it.Next().IsNone() {
+			return option.None[T]()
+		}
+	}
+
+	return it.Next()
+}
+
+// Searches for an element in an iterator, returning its index.
+// Position is short-circuiting, it will stop processing as soon as the predicate returns true.
+func Position[T any](it collections.Iterator[T], f func(T) bool) option.Option[int] {
+	for index, value := 0, it.Next(); value.IsSome(); index, value = index+1, it.Next() {
+		if f(value.Unwrap()) {
+			return option.Some(index)
+		}
+	}
+
+	return option.None[int]()
+}
+
+// Searches for an element in an iterator from the right, returning its index (counted from the front).
+// If the iterator is both double ended and exact sized (like the iterator of a vector), RPosition is short-circuiting.
+// Otherwise, in difference from rust, the whole iterator is consumed to find the last matching element.
+func RPosition[T any](it collections.Iterator[T], f func(T) bool) option.Option[int] {
+	if it, ok := it.(interface {
+		collections.DoubleEndedIterator[T]
+		collections.ExactSizeIterator[T]
+	}); ok {
+		for index, value := it.Len()-1, it.NextBack(); value.IsSome(); index, value = index-1, it.NextBack() {
+			if f(value.Unwrap()) {
+				return option.Some(index)
+			}
+		}
+
+		return option.None[int]()
+	}
+
+	position := option.None[int]()
+
+	for index, value := 0, it.Next(); value.IsSome(); index, value = index+1, it.Next() {
+		if f(value.Unwrap()) {
+			position = option.Some(index)
+		}
+	}
+
+	return position
+}
+
+// Tests if any element of the iterator matches a predicate.
+// Any is short-circuiting, it will stop processing as soon as it finds a true, an empty iterator returns false.
+func Any[T any](it collections.Iterator[T], f func(T) bool) bool {
+	return Position(it, f).IsSome()
+}
+
+// Tests if every element of the iterator matches a predicate.
+// All is short-circuiting, it will stop processing as soon as it finds a false, an empty iterator returns true.
+func All[T any](it collections.Iterator[T], f func(T) bool) bool {
+	return !Any(it, func(value T) bool { return !f(value) })
+}
+
+// Searches for an element of an iterator that satisfies a predicate.
+// Find is short-circuiting, it will stop processing as soon as the predicate returns true.
+func Find[T any](it collections.Iterator[T], f func(T) bool) option.Option[T] {
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		if f(value.Unwrap()) {
+			return value
+		}
+	}
+
+	return option.None[T]()
+}
+
+// Applies a function to the elements of the iterator and returns the first non-None result.
+func FindMap[T any, U any](it collections.Iterator[T], f func(T) option.Option[U]) option.Option[U] {
+	return FilterMap(it, f).Next()
+}
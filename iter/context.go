@@ -0,0 +1,97 @@
+package iter
+
+import (
+	"context"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/option"
+	"github.com/avivatedgi/go-rust-std/result"
+)
+
+// An iterator that ends once its context is done, created by WithContext, FromChan and FromFnContext.
+type ContextIter[T any] struct {
+	ctx context.Context
+	it  collections.Iterator[T]
+	err error
+}
+
+// Creates an iterator that ends once the context is cancelled or its deadline is exceeded.
+// The context is checked before each call to the underlying iterator, so a call that already started is not interrupted,
+// use the context-aware sources (FromChan, FromFnContext) for iterators that may block.
+// After the iterator ends, use Err to find out whether it was exhausted or stopped by the context.
+func WithContext[T any](ctx context.Context, it collections.Iterator[T]) *ContextIter[T] {
+	return &ContextIter[T]{ctx: ctx, it: it}
+}
+
+// Advances the iterator and returns the next value, or None if the iteration is finished or the context is done.
+func (c *ContextIter[T]) Next() option.Option[T] {
+	if c.it == nil {
+		return option.None[T]()
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.stop(err)
+		return option.None[T]()
+	}
+
+	value := c.it.Next()
+	if value.IsNone() {
+		// The underlying iterator may have ended because of the context
+		c.stop(c.ctx.Err())
+	}
+
+	return value
+}
+
+// Returns Err with the error of the context if the iteration was stopped by it, and Ok otherwise.
+func (c *ContextIter[T]) Err() result.Result[struct{}, error] {
+	if c.err != nil {
+		return result.Err[struct{}](c.err)
+	}
+
+	return result.Ok[struct{}, error](struct{}{})
+}
+
+func (c *ContextIter[T]) SizeHint() (int, option.Option[int]) {
+	if c.it == nil {
+		return 0, option.Some(0)
+	}
+
+	// The context may be cancelled at any moment
+	return upperOnly(collections.SizeHint(c.it))
+}
+
+// Releases the underlying iterator, recording the error of the context (if any).
+func (c *ContextIter[T]) stop(err error) {
+	c.it = nil
+	c.err = err
+}
+
+type chanIter[T any] struct {
+	ctx context.Context
+	ch  <-chan T
+}
+
+// Creates an iterator over the values received from the channel, which ends once the channel is closed or the context is done.
+// Unlike ranging over the channel, waiting for a value is interrupted when the context is done.
+func FromChan[T any](ctx context.Context, ch <-chan T) *ContextIter[T] {
+	return WithContext[T](ctx, &chanIter[T]{ctx: ctx, ch: ch})
+}
+
+func (c *chanIter[T]) Next() option.Option[T] {
+	select {
+	case value, ok := <-c.ch:
+		if ok {
+			return option.Some(value)
+		}
+	case <-c.ctx.Done():
+	}
+
+	return option.None[T]()
+}
+
+// Creates an iterator where each iteration calls the provided closure with the context, like FromFn.
+// The closure should return None once the context is done, the iterator also ends without calling it from then on.
+func FromFnContext[T any](ctx context.Context, f func(context.Context) option.Option[T]) *ContextIter[T] {
+	return WithContext(ctx, FromFn(func() option.Option[T] { return f(ctx) }))
+}
@@ -0,0 +1,46 @@
+package iter
+
+import (
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+type revIter[T any] struct {
+	it collections.DoubleEndedIterator[T]
+}
+
+// Reverses an iterator’s direction.
+// Usually, iterators iterate from left to right, after using Rev, an iterator will instead iterate from right to left.
+func Rev[T any](it collections.DoubleEndedIterator[T]) collections.DoubleEndedIterator[T] {
+	return &revIter[T]{it: it}
+}
+
+func (r *revIter[T]) Next() option.Option[T] {
+	return r.it.NextBack()
+}
+
+func (r *revIter[T]) NextBack() option.Option[T] {
+	return r.it.Next()
+}
+
+func (r *revIter[T]) SizeHint() (int, option.Option[int]) {
+	return collections.SizeHint[T](r.it)
+}
+
+// Searches for an element of an iterator from the back that satisfies a predicate.
+// RFind is short-circuiting, it will stop processing as soon as the predicate returns true.
+func RFind[T any](it collections.DoubleEndedIterator[T], f func(T) bool) option.Option[T] {
+	return Find[T](Rev(it), f)
+}
+
+// An iterator function that reduces the iterator’s elements to a single, final value, starting from the back.
+// This is the reverse version of Fold, it takes elements starting from the back of the iterator.
+func RFold[T any, B any](it collections.DoubleEndedIterator[T], initial B, f func(B, T) B) B {
+	return Fold[T](Rev(it), initial, f)
+}
+
+// Returns the nth element from the end of the iterator (zero based), or None if n is greater than or equal to the length of the iterator.
+// All the succeeding elements, as well as the returned element, are consumed from the iterator.
+func NthBack[T any](it collections.DoubleEndedIterator[T], n int) option.Option[T] {
+	return Nth[T](Rev(it), n)
+}
@@ -0,0 +1,91 @@
+package iter
+
+import (
+	"fmt"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/result"
+)
+
+// The collectors in this file consume the iterator, gathering its elements into maps and vectors.
+
+// Groups the elements of the iterator by the key returned from the closure.
+// The elements of each group keep their original order.
+func GroupBy[T any, K comparable](it collections.Iterator[T], key func(T) K) collections.Map[K, collections.Vec[T]] {
+	groups := collections.Map[K, collections.Vec[T]]{}
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		k := key(value.Unwrap())
+		groups[k] = append(groups[k], value.Unwrap())
+	}
+
+	return groups
+}
+
+// Counts the elements of the iterator by the key returned from the closure.
+func CountBy[T any, K comparable](it collections.Iterator[T], key func(T) K) collections.Map[K, int] {
+	counts := collections.Map[K, int]{}
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		counts.Entry(key(value.Unwrap())).AndModify(func(count *int) { *count++ }).OrInsert(1)
+	}
+
+	return counts
+}
+
+// The error returned by IndexBy when two elements resolve to the same key.
+type DuplicateKeyError[K comparable] struct {
+	Key K
+}
+
+func (err DuplicateKeyError[K]) Error() string {
+	return fmt.Sprintf("duplicate key: %v", err.Key)
+}
+
+// Indexes the elements of the iterator by the key returned from the closure, which must be unique.
+// Stops at the first element whose key was already seen and returns a DuplicateKeyError holding that key,
+// the rest of the elements are left in the iterator.
+func IndexBy[T any, K comparable](it collections.Iterator[T], key func(T) K) result.Result[collections.Map[K, T], DuplicateKeyError[K]] {
+	index := collections.Map[K, T]{}
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		k := key(value.Unwrap())
+		if index.Insert(k, value.Unwrap()).IsSome() {
+			return result.Err[collections.Map[K, T]](DuplicateKeyError[K]{Key: k})
+		}
+	}
+
+	return result.Ok[collections.Map[K, T], DuplicateKeyError[K]](index)
+}
+
+// Consumes the iterator, creating two vectors from it, in a Pair.
+// The first vector contains all of the elements for which the predicate returned true, and the second one all of the elements for which it returned false.
+func Partition[T any](it collections.Iterator[T], f func(T) bool) collections.Pair[collections.Vec[T], collections.Vec[T]] {
+	partition := collections.Pair[collections.Vec[T], collections.Vec[T]]{First: collections.Vec[T]{}, Second: collections.Vec[T]{}}
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		if f(value.Unwrap()) {
+			partition.First.Push(value.Unwrap())
+		} else {
+			partition.Second.Push(value.Unwrap())
+		}
+	}
+
+	return partition
+}
+
+// Converts an iterator of pairs into a pair of vectors.
+// The first vector contains the first values of the pairs, and the second one contains the second values, in order.
+func Unzip[A any, B any](it collections.Iterator[collections.Pair[A, B]]) collections.Pair[collections.Vec[A], collections.Vec[B]] {
+	// The hint of third party iterators can't be trusted to be non-negative
+	lower, _ := collections.SizeHint(it)
+	lower = max(lower, 0)
+	unzipped := collections.Pair[collections.Vec[A], collections.Vec[B]]{First: make(collections.Vec[A], 0, lower), Second: make(collections.Vec[B], 0, lower)}
+
+	for pair := it.Next(); pair.IsSome(); pair = it.Next() {
+		unzipped.First.Push(pair.Unwrap().First)
+		unzipped.Second.Push(pair.Unwrap().Second)
+	}
+
+	return unzipped
+}
@@ -0,0 +1,267 @@
+package iter
+
+import (
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// The adapters in this file are based on the ones in the itertools crate (https://docs.rs/itertools/latest/itertools/trait.Itertools.html)
+
+type chunkByIter[T any, K comparable] struct {
+	it      collections.Iterator[T]
+	key     func(T) K
+	peek    option.Option[T]
+	peekKey K
+}
+
+// Creates an iterator that groups consecutive elements that resolve to the same key.
+// Each group is yielded as a pair (first is the key, second is a vector of the elements of the group).
+// The key function is called exactly once per element.
+func ChunkBy[T any, K comparable](it collections.Iterator[T], key func(T) K) collections.Iterator[collections.Pair[K, collections.Vec[T]]] {
+	return &chunkByIter[T, K]{it: it, key: key, peek: option.None[T]()}
+}
+
+func (c *chunkByIter[T, K]) Next() option.Option[collections.Pair[K, collections.Vec[T]]] {
+	first, firstKey := c.peek, c.peekKey
+	if first.IsNone() {
+		first = c.it.Next()
+		if first.IsNone() {
+			return option.None[collections.Pair[K, collections.Vec[T]]]()
+		}
+
+		firstKey = c.key(first.Unwrap())
+	}
+
+	group := collections.Pair[K, collections.Vec[T]]{First: firstKey, Second: collections.Vec[T]{first.Unwrap()}}
+	c.peek = option.None[T]()
+
+	for value := c.it.Next(); value.IsSome(); value = c.it.Next() {
+		if key := c.key(value.Unwrap()); key != group.First {
+			// This element starts the next group, keep its key so it isn't computed again
+			c.peek, c.peekKey = value, key
+			break
+		}
+
+		group.Second.Push(value.Unwrap())
+	}
+
+	return option.Some(group)
+}
+
+type chunksIter[T any] struct {
+	it   collections.Iterator[T]
+	size int
+}
+
+// Creates an iterator over chunks of size elements, the chunks do not overlap.
+// If the number of elements is not divisible by size, the last chunk will be shorter.
+// Panics if size is not positive.
+func Chunks[T any](it collections.Iterator[T], size int) collections.Iterator[collections.Vec[T]] {
+	if size <= 0 {
+		panic("chunk size must be positive")
+	}
+
+	return &chunksIter[T]{it: it, size: size}
+}
+
+func (c *chunksIter[T]) Next() option.Option[collections.Vec[T]] {
+	chunk := collectTake(c.it, c.size)
+	if chunk.IsEmpty() {
+		return option.None[collections.Vec[T]]()
+	}
+
+	return option.Some(chunk)
+}
+
+func (c *chunksIter[T]) SizeHint() (int, option.Option[int]) {
+	// Rounds up without overflowing, the bounds of infinite iterators are math.MaxInt
+	chunks := func(n int) int {
+		if n%c.size == 0 {
+			return n / c.size
+		}
+
+		return n/c.size + 1
+	}
+	lower, upper := collections.SizeHint(c.it)
+	return chunks(lower), option.Map(upper, func(upper *int) int { return chunks(*upper) })
+}
+
+type windowsIter[T any] struct {
+	it     collections.Iterator[T]
+	size   int
+	window collections.Vec[T]
+}
+
+// Creates an iterator over all contiguous windows of size elements, the windows overlap.
+// Each window is a new vector, so it can be kept after the iterator is advanced.
+// If there are fewer than size elements, the iterator yields nothing.
+// Panics if size is not positive.
+func Windows[T any](it collections.Iterator[T], size int) collections.Iterator[collections.Vec[T]] {
+	if size <= 0 {
+		panic("window size must be positive")
+	}
+
+	return &windowsIter[T]{it: it, size: size}
+}
+
+func (w *windowsIter[T]) Next() option.Option[collections.Vec[T]] {
+	if w.window == nil {
+		w.window = collectTake(w.it, w.size)
+		if w.window.Len() < w.size {
+			return option.None[collections.Vec[T]]()
+		}
+	} else {
+		value := w.it.Next()
+		if value.IsNone() {
+			return option.None[collections.Vec[T]]()
+		}
+
+		w.window = append(w.window[1:], value.Unwrap())
+	}
+
+	window := make(collections.Vec[T], w.size)
+	copy(window, w.window)
+	return option.Some(window)
+}
+
+func (w *windowsIter[T]) SizeHint() (int, option.Option[int]) {
+	lower, upper := collections.SizeHint(w.it)
+	if w.window == nil {
+		return subHint(lower, upper, w.size-1)
+	}
+
+	return lower, upper
+}
+
+type interleaveIter[T any] struct {
+	a    collections.Iterator[T]
+	b    collections.Iterator[T]
+	flag bool
+}
+
+// Creates an iterator that alternates elements from two iterators, starting with the first.
+// When one of the iterators is exhausted, the rest of the elements of the other one are yielded.
+func Interleave[T any](a collections.Iterator[T], b collections.Iterator[T]) collections.Iterator[T] {
+	return &interleaveIter[T]{a: a, b: b}
+}
+
+func (i *interleaveIter[T]) Next() option.Option[T] {
+	i.flag = !i.flag
+	if i.flag {
+		if value := i.a.Next(); value.IsSome() {
+			return value
+		}
+
+		return i.b.Next()
+	}
+
+	if value := i.b.Next(); value.IsSome() {
+		return value
+	}
+
+	return i.a.Next()
+}
+
+func (i *interleaveIter[T]) SizeHint() (int, option.Option[int]) {
+	return (&chainIter[T]{a: i.a, b: i.b}).SizeHint()
+}
+
+type intersperseIter[T any] struct {
+	it        collections.Iterator[T]
+	separator T
+	peek      option.Option[T]
+	started   bool
+}
+
+// Creates an iterator that places a copy of separator between adjacent elements of the original iterator.
+func Intersperse[T any](it collections.Iterator[T], separator T) collections.Iterator[T] {
+	return &intersperseIter[T]{it: it, separator: separator, peek: option.None[T]()}
+}
+
+func (i *intersperseIter[T]) Next() option.Option[T] {
+	if !i.started {
+		i.started = true
+		return i.it.Next()
+	}
+
+	if i.peek.IsSome() {
+		value := i.peek
+		i.peek = option.None[T]()
+		return value
+	}
+
+	// Only yield the separator if there is an element after it
+	if i.peek = i.it.Next(); i.peek.IsSome() {
+		return option.Some(i.separator)
+	}
+
+	return option.None[T]()
+}
+
+type uniqueByIter[T any, K comparable] struct {
+	it   collections.Iterator[T]
+	key  func(T) K
+	seen collections.Set[K]
+}
+
+// Creates an iterator that yields only the first occurrence of each element.
+// The elements that were already seen are kept in a hash set.
+func Unique[T comparable](it collections.Iterator[T]) collections.Iterator[T] {
+	return UniqueBy(it, func(value T) T { return value })
+}
+
+// Creates an iterator that yields only the first element resolving to each key.
+// The keys that were already seen are kept in a hash set.
+func UniqueBy[T any, K comparable](it collections.Iterator[T], key func(T) K) collections.Iterator[T] {
+	return &uniqueByIter[T, K]{it: it, key: key, seen: collections.Set[K]{}}
+}
+
+func (u *uniqueByIter[T, K]) Next() option.Option[T] {
+	return Find(u.it, func(value T) bool { return u.seen.Insert(u.key(value)) })
+}
+
+func (u *uniqueByIter[T, K]) SizeHint() (int, option.Option[int]) {
+	lower, upper := collections.SizeHint(u.it)
+	return min(lower, 1), upper
+}
+
+type dedupByIter[T any] struct {
+	it       collections.Iterator[T]
+	f        func(T, T) bool
+	previous option.Option[T]
+}
+
+// Creates an iterator that removes consecutive repeated elements.
+// If the iterator is sorted, this removes all duplicates.
+func Dedup[T comparable](it collections.Iterator[T]) collections.Iterator[T] {
+	return DedupBy(it, func(a, b T) bool { return a == b })
+}
+
+// Creates an iterator that removes all but the first of consecutive elements satisfying a given equality relation.
+// The f function is passed the current element and the previously yielded one, and must determine if they compare equal.
+func DedupBy[T any](it collections.Iterator[T], f func(T, T) bool) collections.Iterator[T] {
+	return &dedupByIter[T]{it: it, f: f, previous: option.None[T]()}
+}
+
+func (d *dedupByIter[T]) Next() option.Option[T] {
+	for value := d.it.Next(); value.IsSome(); value = d.it.Next() {
+		if d.previous.IsSome() && d.f(value.Unwrap(), d.previous.Unwrap()) {
+			continue
+		}
+
+		d.previous = value
+		return value
+	}
+
+	return option.None[T]()
+}
+
+func (d *dedupByIter[T]) SizeHint() (int, option.Option[int]) {
+	lower, upper := collections.SizeHint(d.it)
+	return min(lower, 1), upper
+}
+
+// Collects up to n elements of the iterator into a vector.
+func collectTake[T any](it collections.Iterator[T], n int) collections.Vec[T] {
+	return collections.CollectVec(Take(it, n))
+}
@@ -0,0 +1,184 @@
+package iter
+
+import (
+	"container/heap"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/num"
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// The adapters in this file merge iterators that are already sorted, they are based on the ones in the itertools crate.
+// The comparison functions return a negative number if a < b, zero if a == b and a positive number if a > b (like num.Compare).
+
+type mergeByIter[T any] struct {
+	a       *PeekableIter[T]
+	b       *PeekableIter[T]
+	compare func(a, b T) int
+}
+
+// Creates an iterator that merges two sorted iterators into a single sorted iterator.
+// On equal elements, the element of the first iterator is yielded first.
+func Merge[T num.Ordered](a collections.Iterator[T], b collections.Iterator[T]) collections.Iterator[T] {
+	return MergeBy(a, b, num.Compare[T])
+}
+
+// Creates an iterator that merges two iterators, sorted by the comparison function, into a single sorted iterator.
+// On equal elements, the element of the first iterator is yielded first.
+func MergeBy[T any](a collections.Iterator[T], b collections.Iterator[T], compare func(a, b T) int) collections.Iterator[T] {
+	return &mergeByIter[T]{a: Peekable(a), b: Peekable(b), compare: compare}
+}
+
+func (m *mergeByIter[T]) Next() option.Option[T] {
+	a, b := m.a.Peek(), m.b.Peek()
+	if b.IsNone() || (a.IsSome() && m.compare(a.Unwrap(), b.Unwrap()) <= 0) {
+		return m.a.Next()
+	}
+
+	return m.b.Next()
+}
+
+func (m *mergeByIter[T]) SizeHint() (int, option.Option[int]) {
+	return (&chainIter[T]{a: m.a, b: m.b}).SizeHint()
+}
+
+// A heap of the next element of each iterator, ordered by the comparison function and then by the index of the iterator.
+type kMergeHeap[T any] struct {
+	heads   []collections.Pair[T, int]
+	its     []collections.Iterator[T]
+	compare func(a, b T) int
+}
+
+func (h *kMergeHeap[T]) Len() int {
+	return len(h.heads)
+}
+
+func (h *kMergeHeap[T]) Less(i, j int) bool {
+	if c := h.compare(h.heads[i].First, h.heads[j].First); c != 0 {
+		return c < 0
+	}
+
+	return h.heads[i].Second < h.heads[j].Second
+}
+
+func (h *kMergeHeap[T]) Swap(i, j int) {
+	h.heads[i], h.heads[j] = h.heads[j], h.heads[i]
+}
+
+func (h *kMergeHeap[T]) Push(x any) {
+	h.heads = append(h.heads, x.(collections.Pair[T, int]))
+}
+
+func (h *kMergeHeap[T]) Pop() any {
+	last := h.heads[len(h.heads)-1]
+	h.heads = h.heads[:len(h.heads)-1]
+	return last
+}
+
+type kMergeIter[T any] struct {
+	heap *kMergeHeap[T]
+}
+
+// Creates an iterator that merges any number of sorted iterators into a single sorted iterator.
+// The next element of each iterator is kept in a heap, so each step costs O(log k) for k iterators.
+// On equal elements, the element of the earlier iterator is yielded first.
+func KMerge[T num.Ordered](its ...collections.Iterator[T]) collections.Iterator[T] {
+	return KMergeBy(num.Compare[T], its...)
+}
+
+// Creates an iterator that merges any number of iterators, sorted by the comparison function, into a single sorted iterator.
+// The next element of each iterator is kept in a heap, so each step costs O(log k) for k iterators.
+// On equal elements, the element of the earlier iterator is yielded first.
+func KMergeBy[T any](compare func(a, b T) int, its ...collections.Iterator[T]) collections.Iterator[T] {
+	h := &kMergeHeap[T]{its: its, compare: compare}
+	for index, it := range its {
+		if value := it.Next(); value.IsSome() {
+			h.heads = append(h.heads, collections.Pair[T, int]{First: value.Unwrap(), Second: index})
+		}
+	}
+
+	heap.Init(h)
+	return &kMergeIter[T]{heap: h}
+}
+
+func (k *kMergeIter[T]) Next() option.Option[T] {
+	if k.heap.Len() == 0 {
+		return option.None[T]()
+	}
+
+	// Replace the smallest head with the next element of its iterator, or drop it if the iterator is exhausted
+	head := k.heap.heads[0]
+	if value := k.heap.its[head.Second].Next(); value.IsSome() {
+		k.heap.heads[0].First = value.Unwrap()
+		heap.Fix(k.heap, 0)
+	} else {
+		heap.Pop(k.heap)
+	}
+
+	return option.Some(head.First)
+}
+
+func (k *kMergeIter[T]) SizeHint() (int, option.Option[int]) {
+	lower, upper := k.heap.Len(), option.Some(k.heap.Len())
+	for _, head := range k.heap.heads {
+		itLower, itUpper := collections.SizeHint(k.heap.its[head.Second])
+		lower, upper = sumHints(lower, upper, itLower, itUpper)
+	}
+
+	return lower, upper
+}
+
+// A value that is either a left value, a right value, or both, yielded by MergeJoinBy.
+type EitherOrBoth[L any, R any] struct {
+	Left  option.Option[L]
+	Right option.Option[R]
+}
+
+// Returns true if there is only a left value.
+func (e EitherOrBoth[L, R]) IsLeft() bool {
+	return e.Left.IsSome() && e.Right.IsNone()
+}
+
+// Returns true if there is only a right value.
+func (e EitherOrBoth[L, R]) IsRight() bool {
+	return e.Left.IsNone() && e.Right.IsSome()
+}
+
+// Returns true if there are both a left and a right value.
+func (e EitherOrBoth[L, R]) IsBoth() bool {
+	return e.Left.IsSome() && e.Right.IsSome()
+}
+
+type mergeJoinByIter[L any, R any] struct {
+	left    *PeekableIter[L]
+	right   *PeekableIter[R]
+	compare func(L, R) int
+}
+
+// Creates an iterator that merges two sorted iterators, joining the elements that compare equal, like a sorted merge join.
+// If the left element is smaller it is yielded alone as a left value, if the right element is smaller it is yielded alone as a right value,
+// and if they compare equal they are yielded together as both.
+func MergeJoinBy[L any, R any](left collections.Iterator[L], right collections.Iterator[R], compare func(L, R) int) collections.Iterator[EitherOrBoth[L, R]] {
+	return &mergeJoinByIter[L, R]{left: Peekable(left), right: Peekable(right), compare: compare}
+}
+
+func (m *mergeJoinByIter[L, R]) Next() option.Option[EitherOrBoth[L, R]] {
+	left, right := m.left.Peek(), m.right.Peek()
+
+	switch {
+	case left.IsNone() && right.IsNone():
+		return option.None[EitherOrBoth[L, R]]()
+	case right.IsNone():
+		return option.Some(EitherOrBoth[L, R]{Left: m.left.Next(), Right: option.None[R]()})
+	case left.IsNone():
+		return option.Some(EitherOrBoth[L, R]{Left: option.None[L](), Right: m.right.Next()})
+	}
+
+	if c := m.compare(left.Unwrap(), right.Unwrap()); c < 0 {
+		return option.Some(EitherOrBoth[L, R]{Left: m.left.Next(), Right: option.None[R]()})
+	} else if c > 0 {
+		return option.Some(EitherOrBoth[L, R]{Left: option.None[L](), Right: m.right.Next()})
+	}
+
+	return option.Some(EitherOrBoth[L, R]{Left: m.left.Next(), Right: m.right.Next()})
+}
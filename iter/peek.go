@@ -0,0 +1,147 @@
+package iter
+
+import (
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// An iterator with a Peek method that returns the next element without consuming it, created by Peekable.
+type PeekableIter[T any] struct {
+	it     collections.Iterator[T]
+	peeked option.Option[option.Option[T]]
+}
+
+// Creates an iterator which can use the Peek and NextIf methods to look at the next element of the iterator without consuming it.
+// Note that the underlying iterator is still advanced when Peek is called for the first time.
+func Peekable[T any](it collections.Iterator[T]) *PeekableIter[T] {
+	return &PeekableIter[T]{it: it, peeked: option.None[option.Option[T]]()}
+}
+
+// Advances the iterator and returns the next value.
+func (p *PeekableIter[T]) Next() option.Option[T] {
+	if p.peeked.IsSome() {
+		value := p.peeked.Unwrap()
+		p.peeked = option.None[option.Option[T]]()
+		return value
+	}
+
+	return p.it.Next()
+}
+
+// Returns the next value without advancing the iterator, or None if the iteration is finished.
+func (p *PeekableIter[T]) Peek() option.Option[T] {
+	if p.peeked.IsNone() {
+		p.peeked = option.Some(p.it.Next())
+	}
+
+	return p.peeked.Unwrap()
+}
+
+// Consumes and returns the next value of the iterator if f returns true for it.
+// Otherwise the value is kept for the next call, and None is returned.
+func (p *PeekableIter[T]) NextIf(f func(T) bool) option.Option[T] {
+	if p.Peek().IsSomeWith(func(value *T) bool { return f(*value) }) {
+		return p.Next()
+	}
+
+	return option.None[T]()
+}
+
+func (p *PeekableIter[T]) SizeHint() (int, option.Option[int]) {
+	if p.peeked.IsNone() {
+		return collections.SizeHint(p.it)
+	} else if p.peeked.Unwrap().IsNone() {
+		return 0, option.Some(0)
+	}
+
+	lower, upper := collections.SizeHint(p.it)
+	return addHint(lower, upper, 1)
+}
+
+// Consumes and returns the next value of the iterator if it is equal to expected.
+// Otherwise the value is kept for the next call, and None is returned.
+//
+// NOTE: This function isn't a method of PeekableIter because it can only work on comparable types.
+func NextIfEq[T comparable](p *PeekableIter[T], expected T) option.Option[T] {
+	return p.NextIf(func(value T) bool { return value == expected })
+}
+
+// An iterator that allows putting elements back into it, created by PutBack.
+type PutBackIter[T any] struct {
+	it    collections.Iterator[T]
+	stack collections.Vec[T]
+}
+
+// Creates an iterator that allows putting elements back, so they are yielded again by the following calls to Next.
+func PutBack[T any](it collections.Iterator[T]) *PutBackIter[T] {
+	return &PutBackIter[T]{it: it}
+}
+
+// Advances the iterator and returns the next value, the values that were put back are returned first.
+func (p *PutBackIter[T]) Next() option.Option[T] {
+	if value := p.stack.Pop(); value.IsSome() {
+		return value
+	}
+
+	return p.it.Next()
+}
+
+// Puts a value back into the iterator, so it is returned by the next call to Next.
+// Several values can be put back, they are returned in reverse order (the last one put back is returned first).
+func (p *PutBackIter[T]) PutBack(value T) {
+	p.stack.Push(value)
+}
+
+func (p *PutBackIter[T]) SizeHint() (int, option.Option[int]) {
+	lower, upper := collections.SizeHint(p.it)
+	return addHint(lower, upper, p.stack.Len())
+}
+
+// An iterator that allows peeking at an arbitrary number of elements ahead, created by MultiPeek.
+type MultiPeekIter[T any] struct {
+	it     collections.Iterator[T]
+	buffer collections.Vec[T]
+	cursor int
+}
+
+// Creates an iterator where each call to Peek returns the next element that was not peeked yet, without consuming it.
+// The peek cursor is reset to the next element whenever Next or ResetPeek is called.
+func MultiPeek[T any](it collections.Iterator[T]) *MultiPeekIter[T] {
+	return &MultiPeekIter[T]{it: it}
+}
+
+// Advances the iterator and returns the next value, resetting the peek cursor.
+func (m *MultiPeekIter[T]) Next() option.Option[T] {
+	m.cursor = 0
+	if m.buffer.IsEmpty() {
+		return m.it.Next()
+	}
+
+	return option.Some(m.buffer.Remove(0))
+}
+
+// Returns the element after the previously peeked one (or the next element if nothing was peeked yet) without consuming it.
+// Returns None when there are no more elements.
+func (m *MultiPeekIter[T]) Peek() option.Option[T] {
+	if m.cursor == m.buffer.Len() {
+		value := m.it.Next()
+		if value.IsNone() {
+			return value
+		}
+
+		m.buffer.Push(value.Unwrap())
+	}
+
+	m.cursor++
+	return option.Some(m.buffer[m.cursor-1])
+}
+
+// Resets the peek cursor, so the next call to Peek returns the next element of the iterator.
+func (m *MultiPeekIter[T]) ResetPeek() {
+	m.cursor = 0
+}
+
+func (m *MultiPeekIter[T]) SizeHint() (int, option.Option[int]) {
+	lower, upper := collections.SizeHint(m.it)
+	return addHint(lower, upper, m.buffer.Len())
+}
@@ -0,0 +1,73 @@
+package iter
+
+import (
+	"math"
+
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// Helpers for combining the bounds returned by collections.SizeHint.
+
+// Returns a hint with no lower bound, keeping the upper bound, used by adapters that may drop elements.
+func upperOnly(_ int, upper option.Option[int]) (int, option.Option[int]) {
+	return 0, upper
+}
+
+// Returns the smaller of two upper bounds, where None means there is no upper bound.
+func minUpper(a, b option.Option[int]) option.Option[int] {
+	if a.IsNone() {
+		return b
+	} else if b.IsNone() || a.Unwrap() < b.Unwrap() {
+		return a
+	}
+
+	return b
+}
+
+// Subtracts n from both bounds of a hint, without going below zero.
+func subHint(lower int, upper option.Option[int], n int) (int, option.Option[int]) {
+	return saturatingSub(lower, n), option.Map(upper, func(upper *int) int { return saturatingSub(*upper, n) })
+}
+
+// Adds n to both bounds of a hint, used by adapters that buffer elements.
+// The lower bound saturates at math.MaxInt, and the upper bound becomes None if the sum overflows.
+func addHint(lower int, upper option.Option[int], n int) (int, option.Option[int]) {
+	return sumHints(lower, upper, n, option.Some(n))
+}
+
+// Adds two hints, used by adapters that yield the elements of both iterators.
+// The lower bound saturates at math.MaxInt, and the upper bound becomes None if the sum overflows.
+func sumHints(aLower int, aUpper option.Option[int], bLower int, bUpper option.Option[int]) (int, option.Option[int]) {
+	upper := option.None[int]()
+	if aUpper.IsSome() && bUpper.IsSome() {
+		upper = checkedAdd(aUpper.Unwrap(), bUpper.Unwrap())
+	}
+
+	return saturatingAdd(aLower, bLower), upper
+}
+
+// Adds two non-negative numbers, saturating at math.MaxInt instead of overflowing.
+func saturatingAdd(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+
+	return a + b
+}
+
+// Adds two non-negative numbers, returning None if the sum overflows.
+func checkedAdd(a, b int) option.Option[int] {
+	if a > math.MaxInt-b {
+		return option.None[int]()
+	}
+
+	return option.Some(a + b)
+}
+
+func saturatingSub(a, b int) int {
+	if a < b {
+		return 0
+	}
+
+	return a - b
+}
@@ -0,0 +1,313 @@
+package iter
+
+import (
+	"math"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// The adapters in this file limit or reshape the stream of an iterator without materialising it.
+// Once they are done with the underlying iterator they drop their reference to it, so it can be released.
+
+type takeIter[T any] struct {
+	it collections.Iterator[T]
+	n  int
+}
+
+// Creates an iterator that yields the first n elements, or fewer if the underlying iterator ends sooner.
+func Take[T any](it collections.Iterator[T], n int) collections.Iterator[T] {
+	return &takeIter[T]{it: it, n: n}
+}
+
+func (t *takeIter[T]) Next() option.Option[T] {
+	if t.n <= 0 || t.it == nil {
+		t.it = nil
+		return option.None[T]()
+	}
+
+	t.n--
+	value := t.it.Next()
+	if value.IsNone() || t.n == 0 {
+		t.it = nil
+	}
+
+	return value
+}
+
+func (t *takeIter[T]) SizeHint() (int, option.Option[int]) {
+	if t.n <= 0 || t.it == nil {
+		return 0, option.Some(0)
+	}
+
+	lower, upper := collections.SizeHint(t.it)
+	if lower > t.n {
+		lower = t.n
+	}
+
+	return lower, minUpper(upper, option.Some(t.n))
+}
+
+type skipIter[T any] struct {
+	it collections.Iterator[T]
+	n  int
+}
+
+// Creates an iterator that skips the first n elements.
+// The elements are skipped lazily, when the returned iterator is first advanced.
+func Skip[T any](it collections.Iterator[T], n int) collections.Iterator[T] {
+	return &skipIter[T]{it: it, n: n}
+}
+
+func (s *skipIter[T]) Next() option.Option[T] {
+	for ; s.n > 0; s.n-- {
+		if s.it.Next().IsNone() {
+			s.n = 0
+			return option.None[T]()
+		}
+	}
+
+	return s.it.Next()
+}
+
+func (s *skipIter[T]) SizeHint() (int, option.Option[int]) {
+	lower, upper := collections.SizeHint(s.it)
+	return subHint(lower, upper, s.n)
+}
+
+type takeWhileIter[T any] struct {
+	it collections.Iterator[T]
+	f  func(T) bool
+}
+
+// Creates an iterator that yields elements based on a predicate.
+// The iterator yields elements until the predicate returns false for the first time, the rest of the elements are ignored.
+// Note that the element the predicate returned false for is consumed from the underlying iterator.
+func TakeWhile[T any](it collections.Iterator[T], f func(T) bool) collections.Iterator[T] {
+	return &takeWhileIter[T]{it: it, f: f}
+}
+
+func (t *takeWhileIter[T]) Next() option.Option[T] {
+	if t.it == nil {
+		return option.None[T]()
+	}
+
+	value := t.it.Next()
+	if value.IsSome() && t.f(value.Unwrap()) {
+		return value
+	}
+
+	t.it = nil
+	return option.None[T]()
+}
+
+func (t *takeWhileIter[T]) SizeHint() (int, option.Option[int]) {
+	if t.it == nil {
+		return 0, option.Some(0)
+	}
+
+	return upperOnly(collections.SizeHint(t.it))
+}
+
+type skipWhileIter[T any] struct {
+	it      collections.Iterator[T]
+	f       func(T) bool
+	skipped bool
+}
+
+// Creates an iterator that skips elements based on a predicate.
+// The predicate is called on each element until it returns false, from then on the rest of the elements are yielded.
+func SkipWhile[T any](it collections.Iterator[T], f func(T) bool) collections.Iterator[T] {
+	return &skipWhileIter[T]{it: it, f: f}
+}
+
+func (s *skipWhileIter[T]) Next() option.Option[T] {
+	if s.skipped {
+		return s.it.Next()
+	}
+
+	s.skipped = true
+	for value := s.it.Next(); value.IsSome(); value = s.it.Next() {
+		if !s.f(value.Unwrap()) {
+			return value
+		}
+	}
+
+	return option.None[T]()
+}
+
+func (s *skipWhileIter[T]) SizeHint() (int, option.Option[int]) {
+	if s.skipped {
+		return collections.SizeHint(s.it)
+	}
+
+	return upperOnly(collections.SizeHint(s.it))
+}
+
+type mapWhileIter[T any, U any] struct {
+	it collections.Iterator[T]
+	f  func(T) option.Option[U]
+}
+
+// Creates an iterator that both yields elements based on a predicate and maps.
+// The iterator yields the values returned by the closure, until it returns None for the first time.
+func MapWhile[T any, U any](it collections.Iterator[T], f func(T) option.Option[U]) collections.Iterator[U] {
+	return &mapWhileIter[T, U]{it: it, f: f}
+}
+
+func (m *mapWhileIter[T, U]) Next() option.Option[U] {
+	if m.it == nil {
+		return option.None[U]()
+	}
+
+	value := m.it.Next()
+	if value.IsSome() {
+		if mapped := m.f(value.Unwrap()); mapped.IsSome() {
+			return mapped
+		}
+	}
+
+	m.it = nil
+	return option.None[U]()
+}
+
+func (m *mapWhileIter[T, U]) SizeHint() (int, option.Option[int]) {
+	if m.it == nil {
+		return 0, option.Some(0)
+	}
+
+	return upperOnly(collections.SizeHint(m.it))
+}
+
+type stepByIter[T any] struct {
+	it    collections.Iterator[T]
+	step  int
+	first bool
+}
+
+// Creates an iterator starting at the same point, but stepping by the given amount at each iteration.
+// The first element of the iterator will always be returned, regardless of the step given.
+// Panics if the step is not positive.
+func StepBy[T any](it collections.Iterator[T], step int) collections.Iterator[T] {
+	if step <= 0 {
+		panic("assertion failed: step > 0")
+	}
+
+	return &stepByIter[T]{it: it, step: step, first: true}
+}
+
+func (s *stepByIter[T]) Next() option.Option[T] {
+	if s.first {
+		s.first = false
+		return s.it.Next()
+	}
+
+	for i := 1; i < s.step; i++ {
+		if s.it.Next().IsNone() {
+			return option.None[T]()
+		}
+	}
+
+	return s.it.Next()
+}
+
+func (s *stepByIter[T]) SizeHint() (int, option.Option[int]) {
+	steps := func(n int) int {
+		if s.first {
+			if n == 0 {
+				return 0
+			}
+
+			return 1 + (n-1)/s.step
+		}
+
+		return n / s.step
+	}
+
+	lower, upper := collections.SizeHint(s.it)
+	return steps(lower), option.Map(upper, func(upper *int) int { return steps(*upper) })
+}
+
+type fuseIter[T any] struct {
+	it collections.Iterator[T]
+}
+
+// Creates an iterator which ends after the first None.
+// After an iterator returns None, future calls may or may not yield Some(T) again, Fuse ensures that it will always return None.
+func Fuse[T any](it collections.Iterator[T]) collections.Iterator[T] {
+	return &fuseIter[T]{it: it}
+}
+
+func (f *fuseIter[T]) Next() option.Option[T] {
+	if f.it == nil {
+		return option.None[T]()
+	}
+
+	value := f.it.Next()
+	if value.IsNone() {
+		f.it = nil
+	}
+
+	return value
+}
+
+func (f *fuseIter[T]) SizeHint() (int, option.Option[int]) {
+	if f.it == nil {
+		return 0, option.Some(0)
+	}
+
+	return collections.SizeHint(f.it)
+}
+
+type cycleIter[T any] struct {
+	it     collections.Iterator[T]
+	seen   collections.Vec[T]
+	cursor int
+}
+
+// Repeats an iterator endlessly.
+// Instead of stopping at None, the iterator will start again, from the beginning.
+// In difference from rust, the iterator is not cloned, the elements of the first pass are buffered and replayed instead.
+// If the underlying iterator is empty, the returned iterator is empty as well.
+func Cycle[T any](it collections.Iterator[T]) collections.Iterator[T] {
+	return &cycleIter[T]{it: it}
+}
+
+func (c *cycleIter[T]) Next() option.Option[T] {
+	if c.it != nil {
+		value := c.it.Next()
+		if value.IsSome() {
+			c.seen.Push(value.Unwrap())
+			return value
+		}
+
+		// The first pass is done, replay the buffered elements from now on
+		c.it = nil
+	}
+
+	if c.seen.IsEmpty() {
+		return option.None[T]()
+	}
+
+	value := c.seen[c.cursor]
+	c.cursor = (c.cursor + 1) % c.seen.Len()
+	return option.Some(value)
+}
+
+func (c *cycleIter[T]) SizeHint() (int, option.Option[int]) {
+	if !c.seen.IsEmpty() {
+		return math.MaxInt, option.None[int]()
+	} else if c.it == nil {
+		return 0, option.Some(0)
+	}
+
+	// The iterator is infinite unless the underlying iterator turns out to be empty
+	lower, upper := collections.SizeHint(c.it)
+	if lower > 0 {
+		return math.MaxInt, option.None[int]()
+	} else if upper.IsSomeWith(func(upper *int) bool { return *upper == 0 }) {
+		return 0, option.Some(0)
+	}
+
+	return 0, option.None[int]()
+}
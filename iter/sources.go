@@ -0,0 +1,218 @@
+package iter
+
+import (
+	"math"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/num"
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// The functions in this file create new iterators that are not backed by a collection.
+
+type fromFnIter[T any] struct {
+	f func() option.Option[T]
+}
+
+// Creates a new iterator where each iteration calls the provided closure.
+// This allows creating a custom iterator without defining a new type.
+func FromFn[T any](f func() option.Option[T]) collections.Iterator[T] {
+	return &fromFnIter[T]{f: f}
+}
+
+func (f *fromFnIter[T]) Next() option.Option[T] {
+	return f.f()
+}
+
+type successorsIter[T any] struct {
+	next option.Option[T]
+	f    func(T) option.Option[T]
+}
+
+// Creates a new iterator where each successive item is computed based on the preceding one.
+// The iterator starts with the given first item (if any) and calls the closure to compute each item’s successor,
+// until the closure returns None.
+func Successors[T any](first option.Option[T], f func(T) option.Option[T]) collections.Iterator[T] {
+	return &successorsIter[T]{next: first, f: f}
+}
+
+func (s *successorsIter[T]) Next() option.Option[T] {
+	value := s.next
+	if value.IsSome() {
+		s.next = s.f(value.Unwrap())
+	}
+
+	return value
+}
+
+func (s *successorsIter[T]) SizeHint() (int, option.Option[int]) {
+	if s.next.IsSome() {
+		return 1, option.None[int]()
+	}
+
+	return 0, option.Some(0)
+}
+
+type repeatWithIter[T any] struct {
+	f func() T
+}
+
+// Creates a new iterator that endlessly repeats a single element.
+func Repeat[T any](value T) collections.Iterator[T] {
+	return RepeatWith(func() T { return value })
+}
+
+// Creates a new iterator that repeats elements endlessly by applying the provided closure.
+func RepeatWith[T any](f func() T) collections.Iterator[T] {
+	return &repeatWithIter[T]{f: f}
+}
+
+func (r *repeatWithIter[T]) Next() option.Option[T] {
+	return option.Some(r.f())
+}
+
+func (r *repeatWithIter[T]) SizeHint() (int, option.Option[int]) {
+	return math.MaxInt, option.None[int]()
+}
+
+type repeatNIter[T any] struct {
+	value T
+	n     int
+}
+
+// Creates a new iterator that repeats a single element exactly n times.
+func RepeatN[T any](value T, n int) collections.DoubleEndedIterator[T] {
+	return &repeatNIter[T]{value: value, n: n}
+}
+
+func (r *repeatNIter[T]) Next() option.Option[T] {
+	if r.n <= 0 {
+		return option.None[T]()
+	}
+
+	r.n--
+	return option.Some(r.value)
+}
+
+func (r *repeatNIter[T]) NextBack() option.Option[T] {
+	return r.Next()
+}
+
+func (r *repeatNIter[T]) Len() int {
+	return max(r.n, 0)
+}
+
+// Creates an iterator that yields an element exactly once.
+func Once[T any](value T) collections.DoubleEndedIterator[T] {
+	return RepeatN(value, 1)
+}
+
+// Creates an iterator that yields nothing.
+func Empty[T any]() collections.DoubleEndedIterator[T] {
+	return &repeatNIter[T]{}
+}
+
+// An iterator over a range of integers, created by Range and RangeInclusive.
+type RangeIter[T num.Integer] struct {
+	// Both ends are inclusive, and back is always reachable from front using steps of step
+	front T
+	back  T
+	step  T
+	empty bool
+}
+
+// Creates an iterator over the integers in the half-open range [start, end).
+// If start is greater than or equal to end, the iterator is empty.
+func Range[T num.Integer](start, end T) *RangeIter[T] {
+	if start >= end {
+		return &RangeIter[T]{step: 1, empty: true}
+	}
+
+	return &RangeIter[T]{front: start, back: end - 1, step: 1}
+}
+
+// Creates an iterator over the integers in the closed range [start, end].
+// If start is greater than end, the iterator is empty.
+func RangeInclusive[T num.Integer](start, end T) *RangeIter[T] {
+	if start > end {
+		return &RangeIter[T]{step: 1, empty: true}
+	}
+
+	return &RangeIter[T]{front: start, back: end, step: 1}
+}
+
+// Changes the range to step by the given amount at each iteration, starting from its current front.
+// In difference from the StepBy function, the skipped integers are never computed and the range stays double ended.
+// Panics if the step is not positive.
+func (r *RangeIter[T]) StepBy(step T) *RangeIter[T] {
+	if step <= 0 {
+		panic("assertion failed: step > 0")
+	}
+
+	r.step = step
+	if !r.empty {
+		r.back = r.front + T(r.distance()/uint64(step)*uint64(step))
+	}
+
+	return r
+}
+
+// Advances the iterator and returns the next value.
+func (r *RangeIter[T]) Next() option.Option[T] {
+	if r.empty {
+		return option.None[T]()
+	}
+
+	value := r.front
+	if r.front == r.back {
+		r.empty = true
+	} else {
+		r.front += r.step
+	}
+
+	return option.Some(value)
+}
+
+// Removes and returns an element from the end of the iterator.
+func (r *RangeIter[T]) NextBack() option.Option[T] {
+	if r.empty {
+		return option.None[T]()
+	}
+
+	value := r.back
+	if r.front == r.back {
+		r.empty = true
+	} else {
+		r.back -= r.step
+	}
+
+	return option.Some(value)
+}
+
+// Returns the exact remaining length of the iterator.
+// The length of ranges with more than math.MaxInt elements saturates at math.MaxInt.
+func (r *RangeIter[T]) Len() int {
+	if r.empty {
+		return 0
+	} else if steps := r.distance() / uint64(r.step); steps < math.MaxInt {
+		return int(steps) + 1
+	}
+
+	return math.MaxInt
+}
+
+// Returns the bounds on the remaining length of the iterator.
+// Both are the exact length, unless the range has more than math.MaxInt elements, in which case there is no upper bound.
+func (r *RangeIter[T]) SizeHint() (int, option.Option[int]) {
+	if !r.empty && r.distance()/uint64(r.step) >= math.MaxInt {
+		return math.MaxInt, option.None[int]()
+	}
+
+	return r.Len(), option.Some(r.Len())
+}
+
+// Returns the distance between the ends of the range.
+// The subtraction is done on uint64 so it does not overflow, even for ranges wider than the maximum value of T.
+func (r *RangeIter[T]) distance() uint64 {
+	return uint64(r.back) - uint64(r.front)
+}
@@ -0,0 +1,213 @@
+package iter
+
+import (
+	"sync"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// The shared state of the iterators returned by Tee and TeeBounded.
+// The buffer only holds the elements that were pulled from the underlying iterator but not yet yielded by all of the iterators,
+// offset is the position (in the underlying iterator) of the first element in the buffer.
+// The position of a stopped iterator is -1, it is ignored when trimming the buffer.
+// A capacity of 0 means the buffer is unbounded.
+type teeBuffer[T any] struct {
+	mutex     sync.Mutex
+	trimmed   *sync.Cond
+	it        collections.Iterator[T]
+	buffer    collections.Vec[T]
+	capacity  int
+	offset    int
+	positions []int
+}
+
+// One of the iterators returned by Tee and TeeBounded.
+type TeeIter[T any] struct {
+	shared *teeBuffer[T]
+	index  int
+}
+
+// Splits an iterator into n independent iterators, each yielding all the elements of the underlying iterator.
+// The elements are pulled lazily and buffered until every one of the iterators has yielded them,
+// so the iterators can be advanced in any order, even from a single goroutine.
+// Note that if one iterator gets far ahead of the others, all the elements in between are kept in memory,
+// use TeeBounded to limit the buffer, and call Stop on the iterators you don't need, so they don't hold the others back.
+// The underlying iterator should not be used anymore.
+// Panics if n is negative.
+func Tee[T any](it collections.Iterator[T], n int) collections.Vec[*TeeIter[T]] {
+	return newTee(it, n, 0)
+}
+
+// Same as Tee, but buffers up to capacity elements.
+// When the buffer is full, advancing the iterator that is ahead of the others blocks until the slowest one catches up,
+// so iterators that drift more than capacity elements apart must be advanced from different goroutines.
+// Panics if n is negative or capacity is not positive.
+func TeeBounded[T any](it collections.Iterator[T], n int, capacity int) collections.Vec[*TeeIter[T]] {
+	if capacity <= 0 {
+		panic("tee capacity must be positive")
+	}
+
+	return newTee(it, n, capacity)
+}
+
+func newTee[T any](it collections.Iterator[T], n int, capacity int) collections.Vec[*TeeIter[T]] {
+	if n < 0 {
+		panic("tee count must be >= 0")
+	}
+
+	shared := &teeBuffer[T]{it: it, capacity: capacity, positions: make([]int, n)}
+	shared.trimmed = sync.NewCond(&shared.mutex)
+
+	iterators := make(collections.Vec[*TeeIter[T]], n)
+	for index := range iterators {
+		iterators[index] = &TeeIter[T]{shared: shared, index: index}
+	}
+
+	return iterators
+}
+
+// Advances the iterator and returns the next value.
+// If the buffer of a bounded tee is full, waits for the slowest iterator to yield the oldest buffered element first.
+func (t *TeeIter[T]) Next() option.Option[T] {
+	shared := t.shared
+	shared.mutex.Lock()
+	defer shared.mutex.Unlock()
+
+	for shared.positions[t.index] >= 0 {
+		position := shared.positions[t.index] - shared.offset
+		if position < shared.buffer.Len() {
+			value := shared.buffer[position]
+			shared.positions[t.index]++
+			shared.trim()
+			return option.Some(value)
+		}
+
+		if shared.it == nil {
+			break
+		} else if shared.capacity > 0 && shared.buffer.Len() >= shared.capacity {
+			shared.trimmed.Wait()
+			continue
+		}
+
+		value := shared.it.Next()
+		if value.IsNone() {
+			// The underlying iterator is exhausted, release it
+			shared.it = nil
+			break
+		}
+
+		shared.buffer.Push(value.Unwrap())
+	}
+
+	return option.None[T]()
+}
+
+// Stops the iterator, Next always returns None from now on and the elements are no longer buffered for it.
+// Calling Stop more than once is a no-op.
+func (t *TeeIter[T]) Stop() {
+	shared := t.shared
+	shared.mutex.Lock()
+	defer shared.mutex.Unlock()
+
+	shared.positions[t.index] = -1
+	shared.trim()
+
+	// Wake up the iterator itself, in case another goroutine is waiting in its Next
+	shared.trimmed.Broadcast()
+}
+
+// Returns the bounds on the remaining length of the iterator.
+func (t *TeeIter[T]) SizeHint() (int, option.Option[int]) {
+	shared := t.shared
+	shared.mutex.Lock()
+	defer shared.mutex.Unlock()
+
+	if shared.positions[t.index] < 0 {
+		return 0, option.Some(0)
+	}
+
+	buffered := shared.offset + shared.buffer.Len() - shared.positions[t.index]
+	if shared.it == nil {
+		return buffered, option.Some(buffered)
+	}
+
+	lower, upper := collections.SizeHint(shared.it)
+	return addHint(lower, upper, buffered)
+}
+
+// Drops the elements that were already yielded by all of the running iterators, and wakes up the iterators waiting for room.
+func (shared *teeBuffer[T]) trim() {
+	slowest := shared.offset + shared.buffer.Len()
+	for _, position := range shared.positions {
+		if position >= 0 {
+			slowest = min(slowest, position)
+		}
+	}
+
+	if drop := slowest - shared.offset; drop > 0 {
+		clear(shared.buffer[:drop])
+		shared.buffer = shared.buffer[drop:]
+		shared.offset = slowest
+		shared.trimmed.Broadcast()
+	}
+}
+
+// An iterator that pulls the elements of the underlying iterator ahead of time in a background goroutine, created by Buffered.
+type BufferedIter[T any] struct {
+	values  chan T
+	done    chan struct{}
+	stopped bool
+}
+
+// Creates an iterator that pulls up to size elements ahead of the consumer, in a background goroutine.
+// This is useful when the underlying iterator is slow (for example, does I/O), so producing and consuming the elements can overlap.
+// Unlike the other adapters, the underlying iterator starts being advanced right away, and it should not be used anymore.
+// The goroutine exits once the underlying iterator is exhausted, call Stop if you are done with the iterator before that,
+// otherwise the goroutine is leaked.
+// Panics if size is negative.
+func Buffered[T any](it collections.Iterator[T], size int) *BufferedIter[T] {
+	if size < 0 {
+		panic("buffer size must be >= 0")
+	}
+
+	buffered := &BufferedIter[T]{values: make(chan T, size), done: make(chan struct{})}
+
+	go func() {
+		defer close(buffered.values)
+
+		for value := it.Next(); value.IsSome(); value = it.Next() {
+			select {
+			case buffered.values <- value.Unwrap():
+			case <-buffered.done:
+				return
+			}
+		}
+	}()
+
+	return buffered
+}
+
+// Advances the iterator and returns the next value, waiting for the background goroutine to produce it if needed.
+func (b *BufferedIter[T]) Next() option.Option[T] {
+	if b.stopped {
+		return option.None[T]()
+	}
+
+	value, ok := <-b.values
+	if !ok {
+		return option.None[T]()
+	}
+
+	return option.Some(value)
+}
+
+// Stops the background goroutine, the elements that were already pulled are discarded and Next always returns None from now on.
+// Note that if the goroutine is in the middle of advancing the underlying iterator, it exits once that call returns.
+// Calling Stop more than once is a no-op.
+func (b *BufferedIter[T]) Stop() {
+	if !b.stopped {
+		b.stopped = true
+		close(b.done)
+	}
+}
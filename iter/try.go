@@ -0,0 +1,67 @@
+package iter
+
+import (
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/option"
+	"github.com/avivatedgi/go-rust-std/result"
+)
+
+// Transforms an iterator of results into a result of a vector.
+// If all the elements are Ok, returns Ok with a vector of the contained values, in order.
+// Otherwise, stops at the first Err and returns it, the rest of the elements are left in the iterator.
+func CollectResult[T any, E error](it collections.Iterator[result.Result[T, E]]) result.Result[collections.Vec[T], E] {
+	values := collections.Vec[T]{}
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		res := value.Unwrap()
+		if res.IsErr() {
+			return result.Err[collections.Vec[T]](res.UnwrapErr())
+		}
+
+		values.Push(res.Unwrap())
+	}
+
+	return result.Ok[collections.Vec[T], E](values)
+}
+
+// Transforms an iterator of options into an option of a vector.
+// If all the elements are Some, returns Some with a vector of the contained values, in order.
+// Otherwise, stops at the first None and returns None, the rest of the elements are left in the iterator.
+func CollectOption[T any](it collections.Iterator[option.Option[T]]) option.Option[collections.Vec[T]] {
+	values := collections.Vec[T]{}
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		opt := value.Unwrap()
+		if opt.IsNone() {
+			return option.None[collections.Vec[T]]()
+		}
+
+		values.Push(opt.Unwrap())
+	}
+
+	return option.Some(values)
+}
+
+// An iterator function that applies a function as long as it returns successfully, producing a single, final value.
+// Like Fold, but the closure returns a Result, if it returns an Err, the fold stops and the Err is returned.
+// The rest of the elements are left in the iterator.
+func TryFold[T any, B any, E error](it collections.Iterator[T], initial B, f func(B, T) result.Result[B, E]) result.Result[B, E] {
+	accumulator := initial
+
+	for value := it.Next(); value.IsSome(); value = it.Next() {
+		res := f(accumulator, value.Unwrap())
+		if res.IsErr() {
+			return res
+		}
+
+		accumulator = res.Unwrap()
+	}
+
+	return result.Ok[B, E](accumulator)
+}
+
+// An iterator function that applies a fallible function to each element, stopping at the first Err and returning it.
+// The rest of the elements are left in the iterator.
+func TryForEach[T any, E error](it collections.Iterator[T], f func(T) result.Result[struct{}, E]) result.Result[struct{}, E] {
+	return TryFold(it, struct{}{}, func(_ struct{}, value T) result.Result[struct{}, E] { return f(value) })
+}
@@ -0,0 +1,41 @@
+package num
+
+import (
+	"cmp"
+)
+
+// Signed is a constraint that permits any signed integer type.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint that permits any unsigned integer type.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
+// Ordered is a constraint that permits any type that supports the operators < <= >= >.
+// It is the same constraint as cmp.Ordered, so both can be used interchangeably.
+type Ordered = cmp.Ordered
+
+// Compares two ordered values, returning -1 if a is less than b, 0 if they are equal and +1 if a is greater than b.
+// A floating-point NaN is considered less than any non-NaN value, and equal to another NaN (like cmp.Compare).
+// The result can be used as a comparator function for the By functions of this library.
+func Compare[T Ordered](a, b T) int {
+	return cmp.Compare(a, b)
+}
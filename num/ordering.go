@@ -0,0 +1,86 @@
+package num
+
+// An Ordering is the result of a comparison between two values, based on the one in Rust's standart library (https://doc.rust-lang.org/std/cmp/enum.Ordering.html)
+type Ordering int
+
+const (
+	// An ordering where a compared value is less than another.
+	Less Ordering = -1
+	// An ordering where a compared value is equal to another.
+	Equal Ordering = 0
+	// An ordering where a compared value is greater than another.
+	Greater Ordering = 1
+)
+
+// Converts the result of a comparison function (negative, zero or positive, like Compare) into an Ordering.
+func OrderingOf(c int) Ordering {
+	if c < 0 {
+		return Less
+	} else if c > 0 {
+		return Greater
+	}
+
+	return Equal
+}
+
+// Compares two ordered values, returning their Ordering.
+func Cmp[T Ordered](a, b T) Ordering {
+	return OrderingOf(Compare(a, b))
+}
+
+// Returns true if the ordering is Equal.
+func (ordering Ordering) IsEq() bool {
+	return ordering == Equal
+}
+
+// Returns true if the ordering is not Equal.
+func (ordering Ordering) IsNe() bool {
+	return ordering != Equal
+}
+
+// Returns true if the ordering is Less.
+func (ordering Ordering) IsLt() bool {
+	return ordering == Less
+}
+
+// Returns true if the ordering is Greater.
+func (ordering Ordering) IsGt() bool {
+	return ordering == Greater
+}
+
+// Returns true if the ordering is either Less or Equal.
+func (ordering Ordering) IsLe() bool {
+	return ordering != Greater
+}
+
+// Returns true if the ordering is either Greater or Equal.
+func (ordering Ordering) IsGe() bool {
+	return ordering != Less
+}
+
+// Reverses the ordering, Less becomes Greater, Greater becomes Less and Equal stays Equal.
+func (ordering Ordering) Reverse() Ordering {
+	return -ordering
+}
+
+// Chains two orderings, returns the ordering if it's not Equal, otherwise returns other.
+func (ordering Ordering) Then(other Ordering) Ordering {
+	if ordering == Equal {
+		return other
+	}
+
+	return ordering
+}
+
+func (ordering Ordering) String() string {
+	switch ordering {
+	case Less:
+		return "Less"
+	case Equal:
+		return "Equal"
+	case Greater:
+		return "Greater"
+	default:
+		return "Ordering(invalid)"
+	}
+}
@@ -0,0 +1,39 @@
+package option
+
+// An iterator over the value of an option, created by Option.Iter.
+// It implements the iterator interfaces of the collections package (which can't be imported here, as it imports this package),
+// so it can be used in any iterator pipeline.
+type OptionIter[T any] struct {
+	option Option[T]
+}
+
+// Returns an iterator over the possibly contained value, yielding one element if the option is Some and none otherwise.
+func (option Option[T]) Iter() *OptionIter[T] {
+	return &OptionIter[T]{option: option}
+}
+
+// Advances the iterator and returns the next value.
+func (it *OptionIter[T]) Next() Option[T] {
+	value := it.option
+	it.option = None[T]()
+	return value
+}
+
+// Removes and returns an element from the end of the iterator, the same as Next as there is at most one element.
+func (it *OptionIter[T]) NextBack() Option[T] {
+	return it.Next()
+}
+
+// Returns the exact remaining length of the iterator.
+func (it *OptionIter[T]) Len() int {
+	if it.option.IsSome() {
+		return 1
+	}
+
+	return 0
+}
+
+// Returns the bounds on the remaining length of the iterator, both are the exact length.
+func (it *OptionIter[T]) SizeHint() (int, Option[int]) {
+	return it.Len(), Some(it.Len())
+}
@@ -0,0 +1,105 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSON support for Option, a None is encoded as null and a Some is encoded as its value.
+// A missing field is left untouched while decoding, so it is None as long as the option wasn't set beforehand.
+// Usage example:
+//
+//	type User struct {
+//		Name  string                `json:"name"`
+//		Email option.Option[string] `json:"email,omitzero"`
+//	}
+
+var null = []byte("null")
+
+// Returns true if the option is a None value.
+// This allows the omitzero option of encoding/json (Go 1.24+) to omit None fields.
+func (option Option[T]) IsZero() bool {
+	return option.IsNone()
+}
+
+// Implements json.Marshaler, encoding None as null and Some as the contained value.
+func (option Option[T]) MarshalJSON() ([]byte, error) {
+	if option.IsNone() {
+		return null, nil
+	}
+
+	return json.Marshal(*option.value)
+}
+
+// Implements json.Unmarshaler, decoding null as None and any other value as Some.
+func (option *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), null) {
+		*option = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*option = Some(value)
+	return nil
+}
+
+// An optional JSON field that also tells whether it was present in the decoded JSON, useful for PATCH semantics.
+// An absent field is not present and None, an explicit null is present and None, and any other value is present and Some.
+// Usage example:
+//
+//	type UserPatch struct {
+//		Email option.Field[string] `json:"email,omitzero"`
+//	}
+//
+//	if patch.Email.IsPresent() {
+//		user.Email = patch.Email.Option
+//	}
+type Field[T any] struct {
+	Option[T]
+	present bool
+}
+
+// Return a present Field containing the option.
+func Present[T any](option Option[T]) Field[T] {
+	return Field[T]{Option: option, present: true}
+}
+
+// Return a Field that is not present.
+func Absent[T any]() Field[T] {
+	return Field[T]{Option: None[T]()}
+}
+
+// Returns true if the field was present (including an explicit null).
+func (field Field[T]) IsPresent() bool {
+	return field.present
+}
+
+// Returns true if the field was present with an explicit null.
+func (field Field[T]) IsNull() bool {
+	return field.present && field.IsNone()
+}
+
+// Returns true if the field is not present.
+// This allows the omitzero option of encoding/json (Go 1.24+) to omit absent fields, while keeping explicit nulls.
+func (field Field[T]) IsZero() bool {
+	return !field.present
+}
+
+// Implements json.Marshaler, encoding the field like its option (an absent field is encoded as null unless omitted).
+func (field Field[T]) MarshalJSON() ([]byte, error) {
+	return field.Option.MarshalJSON()
+}
+
+// Implements json.Unmarshaler, marking the field as present and decoding its option.
+func (field *Field[T]) UnmarshalJSON(data []byte) error {
+	if err := field.Option.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	field.present = true
+	return nil
+}
@@ -31,6 +31,11 @@ func (option Option[T]) IsNone() bool {
 	return !option.IsSome()
 }
 
+// Returns true if the option is a None or a Some wrapping a value matching the predicate.
+func (option Option[T]) IsNoneOr(f func(*T) bool) bool {
+	return option.IsNone() || f(option.value)
+}
+
 // Returns the contained Some value, consuming the option value.
 // Panics if the value is a None with a custom panic message provided by message.
 func (option Option[T]) Expect(message string) T {
@@ -106,3 +111,191 @@ func MapOrElse[T any, U any](option Option[T], def func() U, f func(*T) U) U {
 
 	return f(option.value)
 }
+
+// Calls the provided closure with the contained value (if any), and returns the option unchanged.
+func (option Option[T]) Inspect(f func(*T)) Option[T] {
+	if option.IsSome() {
+		f(option.value)
+	}
+
+	return option
+}
+
+// Returns None if the option is None, otherwise calls the predicate with the contained value and returns:
+// Some(value) if the predicate returns true, and None if the predicate returns false.
+func (option Option[T]) Filter(f func(*T) bool) Option[T] {
+	if option.IsSomeWith(f) {
+		return option
+	}
+
+	return None[T]()
+}
+
+// Returns the option if it contains a value, otherwise returns other.
+// Arguments passed to Or are eagerly evaluated, if you are passing the result of a function call, it is recommended to use OrElse.
+func (option Option[T]) Or(other Option[T]) Option[T] {
+	if option.IsSome() {
+		return option
+	}
+
+	return other
+}
+
+// Returns the option if it contains a value, otherwise calls f and returns the result.
+func (option Option[T]) OrElse(f func() Option[T]) Option[T] {
+	if option.IsSome() {
+		return option
+	}
+
+	return f()
+}
+
+// Returns Some if exactly one of the option and other is Some, otherwise returns None.
+func (option Option[T]) Xor(other Option[T]) Option[T] {
+	if option.IsSome() && other.IsNone() {
+		return option
+	} else if option.IsNone() && other.IsSome() {
+		return other
+	}
+
+	return None[T]()
+}
+
+// Returns None if the option is None, otherwise returns other.
+// This function is not a method of option because method must have no type parameter.
+// https://github.com/golang/go/issues/48793
+func And[T any, U any](option Option[T], other Option[U]) Option[U] {
+	if option.IsNone() {
+		return None[U]()
+	}
+
+	return other
+}
+
+// Returns None if the option is None, otherwise calls f with the wrapped value and returns the result.
+// Some languages call this operation flatmap.
+// This function is not a method of option because method must have no type parameter.
+// https://github.com/golang/go/issues/48793
+func AndThen[T any, U any](option Option[T], f func(*T) Option[U]) Option[U] {
+	if option.IsNone() {
+		return None[U]()
+	}
+
+	return f(option.value)
+}
+
+// A pair of values, used by Zip and Unzip.
+// The Pair of the collections package can't be used here, as it imports this package.
+type Pair[T any, U any] struct {
+	First  T
+	Second U
+}
+
+// Zips the option with another option.
+// If the option is Some(a) and other is Some(b), returns Some(Pair{a, b}), otherwise None is returned.
+// This function is not a method of option because method must have no type parameter.
+// https://github.com/golang/go/issues/48793
+func Zip[T any, U any](option Option[T], other Option[U]) Option[Pair[T, U]] {
+	return ZipWith(option, other, func(a *T, b *U) Pair[T, U] { return Pair[T, U]{First: *a, Second: *b} })
+}
+
+// Zips the option and another option with the function f.
+// If the option is Some(a) and other is Some(b), returns Some(f(a, b)), otherwise None is returned.
+// This function is not a method of option because method must have no type parameter.
+// https://github.com/golang/go/issues/48793
+func ZipWith[T any, U any, R any](option Option[T], other Option[U], f func(*T, *U) R) Option[R] {
+	if option.IsNone() || other.IsNone() {
+		return None[R]()
+	}
+
+	return Some(f(option.value, other.value))
+}
+
+// Unzips an option containing a pair into a pair of options.
+// If the option is Some(Pair{a, b}), returns (Some(a), Some(b)), otherwise (None, None) is returned.
+// This function is not a method of option because it only works on options of pairs.
+func Unzip[T any, U any](option Option[Pair[T, U]]) (Option[T], Option[U]) {
+	if option.IsNone() {
+		return None[T](), None[U]()
+	}
+
+	return Some(option.value.First), Some(option.value.Second)
+}
+
+// Converts from Option[Option[T]] to Option[T], removing one level of nesting at a time.
+// This function is not a method of option because it only works on nested options.
+func Flatten[T any](option Option[Option[T]]) Option[T] {
+	if option.IsNone() {
+		return None[T]()
+	}
+
+	return *option.value
+}
+
+// Takes the value out of the option, leaving a None in its place.
+func (option *Option[T]) Take() Option[T] {
+	value := *option
+	*option = None[T]()
+	return value
+}
+
+// Takes the value out of the option, but only if the predicate evaluates to true on it, leaving a None in its place.
+// Otherwise the option is left unchanged and None is returned.
+func (option *Option[T]) TakeIf(f func(*T) bool) Option[T] {
+	if option.IsSomeWith(f) {
+		return option.Take()
+	}
+
+	return None[T]()
+}
+
+// Replaces the actual value in the option by the value given in parameter, returning the old value if present.
+func (option *Option[T]) Replace(value T) Option[T] {
+	old := *option
+	*option = Some(value)
+	return old
+}
+
+// Inserts value into the option, then returns a pointer to it.
+// If the option already contains a value, the old value is dropped.
+// Note that copies of the option made after the insertion share the value, so changing it through the pointer affects them too.
+func (option *Option[T]) Insert(value T) *T {
+	*option = Some(value)
+	return option.value
+}
+
+// Inserts value into the option if it is None, then returns a pointer to the contained value.
+// Note that copies of an option share the contained value, so changing it through the pointer affects all of them,
+// for example when a struct with a lazily initialised field was copied after the field was set.
+func (option *Option[T]) GetOrInsert(value T) *T {
+	if option.IsNone() {
+		*option = Some(value)
+	}
+
+	return option.value
+}
+
+// Inserts a value computed from f into the option if it is None, then returns a pointer to the contained value.
+// Like GetOrInsert, changing the value through the pointer affects every copy of the option sharing it.
+func (option *Option[T]) GetOrInsertWith(f func() T) *T {
+	if option.IsNone() {
+		*option = Some(f())
+	}
+
+	return option.value
+}
+
+// Returns a pointer to the contained value, or nil if the option is None.
+// Note that copies of an option share the contained value, so changing the value through the pointer affects all of them.
+func (option Option[T]) AsPtr() *T {
+	return option.value
+}
+
+// Converts a pointer into an option, nil becomes None and any other pointer becomes Some with a copy of the value it points to.
+func FromPtr[T any](ptr *T) Option[T] {
+	if ptr == nil {
+		return None[T]()
+	}
+
+	return Some(*ptr)
+}
@@ -0,0 +1,40 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// database/sql support for Option, SQL NULL is mapped to None and any other value to Some.
+// This allows using Option instead of sql.NullString, sql.NullInt64 and friends.
+// Usage example:
+//
+//	var email option.Option[string]
+//	err := db.QueryRow("SELECT email FROM users WHERE id = ?", id).Scan(&email)
+
+// Implements sql.Scanner, scanning NULL as None and any other value as Some.
+// The value is converted the same way sql.Rows.Scan does, using the scanner of T if it implements sql.Scanner.
+func (option *Option[T]) Scan(src any) error {
+	if src == nil {
+		*option = None[T]()
+		return nil
+	}
+
+	var value sql.Null[T]
+	if err := value.Scan(src); err != nil {
+		return err
+	}
+
+	*option = Some(value.V)
+	return nil
+}
+
+// Implements driver.Valuer, mapping None to NULL and Some to the contained value.
+// The value is converted with driver.DefaultParameterConverter, using the valuer of T if it implements driver.Valuer.
+func (option Option[T]) Value() (driver.Value, error) {
+	if option.IsNone() {
+		return nil, nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(*option.value)
+}
@@ -0,0 +1,259 @@
+package par
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/option"
+	"github.com/avivatedgi/go-rust-std/result"
+)
+
+// A parallel iterator, inspired by rayon's ParallelIterator (https://docs.rs/rayon/latest/rayon/iter/trait.ParallelIterator.html)
+// Adapters (Map, Filter) are lazy and fused together, the work only starts when a consumer (ForEach, Reduce, Collect) is called.
+// The elements are split into contiguous chunks which are handed out to a bounded pool of worker goroutines.
+// If a closure panics in a worker, no new chunks are handed out and the panic is returned as an Err(PanicError) by the consumer.
+type Iter[T any] struct {
+	len     int
+	get     func(index int) option.Option[T]
+	workers int
+	ordered bool
+}
+
+// The error returned by the consumers of Iter when a closure panics in one of the workers.
+type PanicError struct {
+	// The value passed to panic.
+	Value any
+	// The stack trace of the panicking worker.
+	Stack []byte
+}
+
+func (err PanicError) Error() string {
+	return fmt.Sprintf("panic in parallel iterator worker: %v", err.Value)
+}
+
+// Creates a parallel iterator over the elements of the vector.
+// By default, the number of workers is GOMAXPROCS and the order of the elements is not kept.
+func FromVec[T any](vec collections.Vec[T]) *Iter[T] {
+	return &Iter[T]{
+		len:     vec.Len(),
+		get:     func(index int) option.Option[T] { return option.Some(vec[index]) },
+		workers: runtime.GOMAXPROCS(0),
+	}
+}
+
+// Creates a parallel iterator over the key-value pairs of the map (first is key, second is value).
+// The pairs are collected when the iterator is created, so later changes to the map are not reflected by it.
+func FromMap[K comparable, V any](m collections.Map[K, V]) *Iter[collections.Pair[K, V]] {
+	return FromVec(collections.CollectVec(m.Iter()))
+}
+
+// Sets the number of worker goroutines used by the consumers, values below one are treated as one.
+func (it *Iter[T]) WithWorkers(workers int) *Iter[T] {
+	it.workers = max(workers, 1)
+	return it
+}
+
+// Makes Collect keep the order of the elements, at the cost of buffering the output of every chunk.
+// Reduce always combines the chunks in order, and ForEach never runs in order.
+func (it *Iter[T]) Ordered() *Iter[T] {
+	it.ordered = true
+	return it
+}
+
+// Creates a parallel iterator which calls the closure on each element.
+// This function is not a method of Iter because method must have no type parameter.
+// https://github.com/golang/go/issues/48793
+func Map[T any, U any](it *Iter[T], f func(T) U) *Iter[U] {
+	return &Iter[U]{
+		len: it.len,
+		get: func(index int) option.Option[U] {
+			value := it.get(index)
+			if value.IsNone() {
+				return option.None[U]()
+			}
+
+			return option.Some(f(value.Unwrap()))
+		},
+		workers: it.workers,
+		ordered: it.ordered,
+	}
+}
+
+// Creates a parallel iterator which only yields the elements for which the closure returns true.
+func (it *Iter[T]) Filter(f func(T) bool) *Iter[T] {
+	return &Iter[T]{
+		len: it.len,
+		get: func(index int) option.Option[T] {
+			if value := it.get(index); value.IsSomeWith(func(value *T) bool { return f(*value) }) {
+				return value
+			}
+
+			return option.None[T]()
+		},
+		workers: it.workers,
+		ordered: it.ordered,
+	}
+}
+
+// Executes the closure on each element, in parallel and in no particular order.
+func (it *Iter[T]) ForEach(f func(T)) result.Result[struct{}, PanicError] {
+	panicked := it.run(func(_, start, end int) {
+		for index := start; index < end; index++ {
+			if value := it.get(index); value.IsSome() {
+				f(value.Unwrap())
+			}
+		}
+	})
+
+	if panicked.IsSome() {
+		return result.Err[struct{}](panicked.Unwrap())
+	}
+
+	return result.Ok[struct{}, PanicError](struct{}{})
+}
+
+// Reduces the elements to a single one, by repeatedly applying a reducing operation, or None if there are no elements.
+// Each chunk is reduced by a worker, then the results of the chunks are reduced in order,
+// so the operation must be associative, but it does not have to be commutative.
+func (it *Iter[T]) Reduce(f func(T, T) T) result.Result[option.Option[T], PanicError] {
+	combine := func(accumulator option.Option[T], value option.Option[T]) option.Option[T] {
+		if value.IsNone() {
+			return accumulator
+		} else if accumulator.IsNone() {
+			return value
+		}
+
+		return option.Some(f(accumulator.Unwrap(), value.Unwrap()))
+	}
+
+	reduced := make([]option.Option[T], it.chunks())
+	panicked := it.run(func(chunk, start, end int) {
+		accumulator := option.None[T]()
+		for index := start; index < end; index++ {
+			accumulator = combine(accumulator, it.get(index))
+		}
+
+		reduced[chunk] = accumulator
+	})
+
+	// The results of the chunks are combined on this goroutine, so f may still panic here
+	accumulator := option.None[T]()
+	if panicked.IsNone() {
+		panicked = catch(func() {
+			for _, value := range reduced {
+				accumulator = combine(accumulator, value)
+			}
+		})
+	}
+
+	if panicked.IsSome() {
+		return result.Err[option.Option[T]](panicked.Unwrap())
+	}
+
+	return result.Ok[option.Option[T], PanicError](accumulator)
+}
+
+// Collects the elements into a vector.
+// If the iterator is Ordered, the elements keep their original order, otherwise the chunks are appended as they complete.
+func (it *Iter[T]) Collect() result.Result[collections.Vec[T], PanicError] {
+	var mutex sync.Mutex
+	chunks := make([]collections.Vec[T], it.chunks())
+	collected := make(collections.Vec[T], 0, it.len)
+
+	panicked := it.run(func(chunk, start, end int) {
+		values := make(collections.Vec[T], 0, end-start)
+		for index := start; index < end; index++ {
+			if value := it.get(index); value.IsSome() {
+				values.Push(value.Unwrap())
+			}
+		}
+
+		if it.ordered {
+			chunks[chunk] = values
+			return
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		collected = append(collected, values...)
+	})
+
+	if panicked.IsSome() {
+		return result.Err[collections.Vec[T]](panicked.Unwrap())
+	}
+
+	if it.ordered {
+		for _, values := range chunks {
+			collected = append(collected, values...)
+		}
+	}
+
+	return result.Ok[collections.Vec[T], PanicError](collected)
+}
+
+// Returns the number of elements in each chunk, there are a few chunks per worker to balance uneven work.
+func (it *Iter[T]) chunkSize() int {
+	return max(it.len/(it.workers*4), 1)
+}
+
+// Returns the number of chunks the elements are split into.
+func (it *Iter[T]) chunks() int {
+	return (it.len + it.chunkSize() - 1) / it.chunkSize()
+}
+
+// Hands out the chunks to the workers, calling f with the index and the bounds ([start, end)) of each chunk.
+// After the first panic no new chunks are handed out, the panic is recovered and returned once all workers are done.
+func (it *Iter[T]) run(f func(chunk, start, end int)) option.Option[PanicError] {
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		next     atomic.Int64
+		failed   atomic.Bool
+		panicked = option.None[PanicError]()
+	)
+
+	chunks, chunkSize := it.chunks(), it.chunkSize()
+	for range min(it.workers, chunks) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			recovered := catch(func() {
+				for !failed.Load() {
+					chunk := int(next.Add(1) - 1)
+					if chunk >= chunks {
+						return
+					}
+
+					start := chunk * chunkSize
+					f(chunk, start, min(start+chunkSize, it.len))
+				}
+			})
+
+			if recovered.IsSome() {
+				failed.Store(true)
+				once.Do(func() { panicked = recovered })
+			}
+		}()
+	}
+
+	wg.Wait()
+	return panicked
+}
+
+// Calls f, recovering a panic into a PanicError.
+func catch(f func()) (panicked option.Option[PanicError]) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = option.Some(PanicError{Value: r, Stack: debug.Stack()})
+		}
+	}()
+
+	f()
+	return option.None[PanicError]()
+}
@@ -0,0 +1,64 @@
+package result
+
+import (
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// Conversions between Option and Result.
+// In rust some of them are methods of Option, here they live in this package, because it imports the option package
+// (the option package can't import this one back, as Go doesn't allow import cycles).
+
+// Transforms the Option[T] into a Result[T, E], mapping Some(v) to Ok(v) and None to Err(err).
+// Arguments passed to OkOr are eagerly evaluated, if you are passing the result of a function call, it is recommended to use OkOrElse.
+func OkOr[T any, E error](value option.Option[T], err E) Result[T, E] {
+	if value.IsSome() {
+		return Ok[T, E](value.Unwrap())
+	}
+
+	return Err[T](err)
+}
+
+// Transforms the Option[T] into a Result[T, E], mapping Some(v) to Ok(v) and None to Err(f()).
+func OkOrElse[T any, E error](value option.Option[T], f func() E) Result[T, E] {
+	if value.IsSome() {
+		return Ok[T, E](value.Unwrap())
+	}
+
+	return Err[T](f())
+}
+
+// Transposes a Result of an Option into an Option of a Result.
+// Ok(None) will be mapped to None, Ok(Some(v)) and Err(e) will be mapped to Some(Ok(v)) and Some(Err(e)).
+func Transpose[T any, E error](result Result[option.Option[T], E]) option.Option[Result[T, E]] {
+	if result.IsErr() {
+		return option.Some(Err[T](*result.err))
+	} else if result.value.IsNone() {
+		return option.None[Result[T, E]]()
+	}
+
+	return option.Some(Ok[T, E](result.value.Unwrap()))
+}
+
+// Transposes an Option of a Result into a Result of an Option.
+// None will be mapped to Ok(None), Some(Ok(v)) and Some(Err(e)) will be mapped to Ok(Some(v)) and Err(e).
+func TransposeOption[T any, E error](value option.Option[Result[T, E]]) Result[option.Option[T], E] {
+	if value.IsNone() {
+		return Ok[option.Option[T], E](option.None[T]())
+	}
+
+	inner := value.Unwrap()
+	if inner.IsErr() {
+		return Err[option.Option[T]](*inner.err)
+	}
+
+	return Ok[option.Option[T], E](option.Some(*inner.value))
+}
+
+// Converts from Result[Result[T, E], E] to Result[T, E], removing one level of nesting at a time.
+func Flatten[T any, E error](result Result[Result[T, E], E]) Result[T, E] {
+	if result.IsErr() {
+		return Err[T](*result.err)
+	}
+
+	return *result.value
+}
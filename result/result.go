@@ -61,6 +61,11 @@ func (result Result[_, E]) Err() option.Option[E] {
 	return option.None[E]()
 }
 
+// Returns an iterator over the possibly contained value, yielding one element if the result is Ok and none otherwise.
+func (result Result[T, _]) Iter() *option.OptionIter[T] {
+	return result.Ok().Iter()
+}
+
 // Returns the contained Ok value, consuming the self value.
 // Panics if the value is an Err, with a panic message including the passed message, and the content of the Err.
 func (result Result[T, E]) Expect(message string) T {
@@ -1,9 +1,31 @@
 package tests
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+)
 
 func ShouldPanic(t *testing.T) {
 	if r := recover(); r == nil {
 		t.Errorf("expected a panic but got none")
 	}
 }
+
+// Consumes the iterator and checks that it yielded exactly the expected values, in order.
+func ExpectValues[T comparable](t *testing.T, name string, it collections.Iterator[T], expectedValues []T) {
+	t.Helper()
+
+	values := *collections.IntoVector(it)
+	if len(values) != len(expectedValues) {
+		t.Errorf("expected `%s` to yield %v but got %v", name, expectedValues, values)
+		return
+	}
+
+	for i, v := range values {
+		if v != expectedValues[i] {
+			t.Errorf("expected `%s` to yield %v but got %v", name, expectedValues, values)
+			return
+		}
+	}
+}
@@ -0,0 +1,1336 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/iter"
+	"github.com/avivatedgi/go-rust-std/num"
+	"github.com/avivatedgi/go-rust-std/option"
+	"github.com/avivatedgi/go-rust-std/result"
+)
+
+func TestIterMap(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	mapped := iter.Map[int](vec.Iter(), func(v int) string { return strconv.Itoa(v * 2) })
+	ExpectValues(t, "iter.Map", mapped, []string{"2", "4", "6"})
+}
+
+func TestIterFilter(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4, 5}
+	filtered := iter.Filter[int](vec.Iter(), func(v int) bool { return v%2 == 1 })
+	ExpectValues(t, "iter.Filter", filtered, []int{1, 3, 5})
+}
+
+func TestIterFilterMap(t *testing.T) {
+	vec := collections.Vec[string]{"1", "two", "3", "four"}
+	parsed := iter.FilterMap[string](vec.Iter(), func(v string) option.Option[int] {
+		if n, err := strconv.Atoi(v); err == nil {
+			return option.Some(n)
+		}
+
+		return option.None[int]()
+	})
+
+	ExpectValues(t, "iter.FilterMap", parsed, []int{1, 3})
+}
+
+func TestIterFlatMap(t *testing.T) {
+	vec := collections.Vec[int]{0, 1, 2, 3}
+	flat := iter.FlatMap[int](vec.Iter(), func(v int) collections.Iterator[int] {
+		inner := collections.Vec[int]{}
+		inner.Resize(v, v)
+		return inner.Iter()
+	})
+
+	ExpectValues(t, "iter.FlatMap", flat, []int{1, 2, 2, 3, 3, 3})
+}
+
+func TestIterFlatten(t *testing.T) {
+	a := collections.Vec[int]{1, 2}
+	b := collections.Vec[int]{}
+	c := collections.Vec[int]{3}
+	vec := collections.Vec[collections.Iterator[int]]{a.Iter(), b.Iter(), c.Iter()}
+	ExpectValues(t, "iter.Flatten", iter.Flatten[int](vec.Iter()), []int{1, 2, 3})
+}
+
+func TestIterEnumerate(t *testing.T) {
+	vec := collections.Vec[string]{"a", "b", "c"}
+	enumerated := iter.Enumerate[string](vec.Iter())
+
+	expectedPairs := []collections.Pair[int, string]{{First: 0, Second: "a"}, {First: 1, Second: "b"}, {First: 2, Second: "c"}}
+	ExpectValues(t, "iter.Enumerate", enumerated, expectedPairs)
+}
+
+func TestIterZip(t *testing.T) {
+	a := collections.Vec[int]{1, 2, 3}
+	b := collections.Vec[string]{"a", "b"}
+	zipped := iter.Zip[int, string](a.Iter(), b.Iter())
+
+	expectedPairs := []collections.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}
+	ExpectValues(t, "iter.Zip", zipped, expectedPairs)
+}
+
+func TestIterChain(t *testing.T) {
+	a := collections.Vec[int]{1, 2}
+	b := collections.Vec[int]{3, 4}
+	ExpectValues(t, "iter.Chain", iter.Chain[int](a.Iter(), b.Iter()), []int{1, 2, 3, 4})
+}
+
+func TestIterInspect(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	seen := collections.Vec[int]{}
+	inspected := iter.Inspect[int](vec.Iter(), func(v int) { seen.Push(v) })
+
+	if seen.Len() != 0 {
+		t.Error("expected `iter.Inspect` to be lazy")
+	}
+
+	ExpectValues(t, "iter.Inspect", inspected, []int{1, 2, 3})
+	ExpectValues[int](t, "seen", seen.Iter(), []int{1, 2, 3})
+}
+
+func TestIterScan(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+	scanned := iter.Scan[int](vec.Iter(), 1, func(state *int, v int) option.Option[int] {
+		*state *= v
+		if *state > 6 {
+			return option.None[int]()
+		}
+
+		return option.Some(-*state)
+	})
+
+	ExpectValues(t, "iter.Scan", scanned, []int{-1, -2, -6})
+}
+
+func TestIterPipeline(t *testing.T) {
+	m := collections.Map[string, int]{"a": 1, "b": 2, "c": 3}
+	sum := 0
+	filtered := iter.Filter(iter.Map(m.Values(), func(v int) int { return v * 10 }), func(v int) bool { return v != 20 })
+
+	for value := filtered.Next(); value.IsSome(); value = filtered.Next() {
+		sum += value.Unwrap()
+	}
+
+	if sum != 40 {
+		t.Errorf("expected `sum` to be 40 but got %d", sum)
+	}
+}
+
+func TestIterTake(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4, 5}
+	pulled := 0
+	counted := iter.Inspect[int](vec.Iter(), func(int) { pulled++ })
+
+	ExpectValues(t, "iter.Take", iter.Take(counted, 2), []int{1, 2})
+	if pulled != 2 {
+		t.Errorf("expected `iter.Take` to pull 2 elements but pulled %d", pulled)
+	}
+
+	ExpectValues(t, "iter.Take", iter.Take[int](vec.Iter(), 10), []int{1, 2, 3, 4, 5})
+	ExpectValues(t, "iter.Take", iter.Take[int](vec.Iter(), 0), []int{})
+}
+
+func TestIterSkip(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4, 5}
+	ExpectValues(t, "iter.Skip", iter.Skip[int](vec.Iter(), 3), []int{4, 5})
+	ExpectValues(t, "iter.Skip", iter.Skip[int](vec.Iter(), 10), []int{})
+}
+
+func TestIterTakeWhile(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 1, 2}
+	ExpectValues(t, "iter.TakeWhile", iter.TakeWhile[int](vec.Iter(), func(v int) bool { return v < 3 }), []int{1, 2})
+}
+
+func TestIterSkipWhile(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 1, 2}
+	ExpectValues(t, "iter.SkipWhile", iter.SkipWhile[int](vec.Iter(), func(v int) bool { return v < 3 }), []int{3, 1, 2})
+}
+
+func TestIterMapWhile(t *testing.T) {
+	vec := collections.Vec[int]{4, 2, 0, 1}
+	divided := iter.MapWhile[int](vec.Iter(), func(v int) option.Option[int] {
+		if v == 0 {
+			return option.None[int]()
+		}
+
+		return option.Some(8 / v)
+	})
+
+	ExpectValues(t, "iter.MapWhile", divided, []int{2, 4})
+}
+
+func TestIterStepBy(t *testing.T) {
+	vec := collections.Vec[int]{0, 1, 2, 3, 4, 5, 6}
+	ExpectValues(t, "iter.StepBy", iter.StepBy[int](vec.Iter(), 3), []int{0, 3, 6})
+	ExpectValues(t, "iter.StepBy", iter.StepBy[int](vec.Iter(), 1), []int{0, 1, 2, 3, 4, 5, 6})
+}
+
+func TestIterStepByPanic(t *testing.T) {
+	defer ShouldPanic(t)
+
+	vec := collections.Vec[int]{}
+	iter.StepBy[int](vec.Iter(), 0)
+}
+
+// An iterator that alternates between yielding a value and None.
+type flickeringIter struct {
+	calls int
+}
+
+func (f *flickeringIter) Next() option.Option[int] {
+	f.calls++
+	if f.calls%2 == 0 {
+		return option.None[int]()
+	}
+
+	return option.Some(f.calls)
+}
+
+func TestIterFuse(t *testing.T) {
+	fused := iter.Fuse[int](&flickeringIter{})
+
+	if fused.Next().Unwrap() != 1 {
+		t.Error("expected 1st `fused.Next()` to be `Some(1)`")
+	} else if fused.Next().IsSome() {
+		t.Error("expected 2nd `fused.Next()` to be `None`")
+	} else if fused.Next().IsSome() {
+		t.Error("expected 3rd `fused.Next()` to be `None`")
+	}
+}
+
+func TestIterCycle(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	ExpectValues(t, "iter.Cycle", iter.Take(iter.Cycle[int](vec.Iter()), 7), []int{1, 2, 3, 1, 2, 3, 1})
+
+	empty := collections.Vec[int]{}
+	ExpectValues(t, "iter.Cycle", iter.Cycle[int](empty.Iter()), []int{})
+}
+
+func TestIterFold(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	joined := iter.Fold[int](vec.Iter(), "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+
+	if joined != "123" {
+		t.Errorf("expected `iter.Fold` to be \"123\" but got %q", joined)
+	}
+}
+
+func TestIterReduce(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	max := func(a, b int) int {
+		if a > b {
+			return a
+		}
+
+		return b
+	}
+
+	if iter.Reduce[int](vec.Iter(), max).Unwrap() != 3 {
+		t.Error("expected `iter.Reduce` to be `Some(3)`")
+	}
+
+	empty := collections.Vec[int]{}
+	if iter.Reduce[int](empty.Iter(), max).IsSome() {
+		t.Error("expected `iter.Reduce` on an empty iterator to be `None`")
+	}
+}
+
+func TestIterCount(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+	if count := iter.Count(iter.Filter[int](vec.Iter(), func(v int) bool { return v > 1 })); count != 3 {
+		t.Errorf("expected `iter.Count` to be 3 but got %d", count)
+	}
+}
+
+func TestIterLast(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	if iter.Last[int](vec.Iter()).Unwrap() != 3 {
+		t.Error("expected `iter.Last` to be `Some(3)`")
+	}
+
+	empty := collections.Vec[int]{}
+	if iter.Last[int](empty.Iter()).IsSome() {
+		t.Error("expected `iter.Last` on an empty iterator to be `None`")
+	}
+}
+
+func TestIterNth(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+	values := vec.Iter()
+
+	if iter.Nth[int](values, 1).Unwrap() != 2 {
+		t.Error("expected `iter.Nth(values, 1)` to be `Some(2)`")
+	} else if iter.Nth[int](values, 0).Unwrap() != 3 {
+		t.Error("expected `iter.Nth(values, 0)` to be `Some(3)`")
+	} else if iter.Nth[int](values, 1).IsSome() {
+		t.Error("expected `iter.Nth(values, 1)` to be `None`")
+	}
+}
+
+func TestIterPosition(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 2}
+	isTwo := func(v int) bool { return v == 2 }
+
+	if iter.Position[int](vec.Iter(), isTwo).Unwrap() != 1 {
+		t.Error("expected `iter.Position` to be `Some(1)`")
+	} else if iter.RPosition[int](vec.Iter(), isTwo).Unwrap() != 3 {
+		t.Error("expected `iter.RPosition` to be `Some(3)`")
+	} else if iter.Position[int](vec.Iter(), func(v int) bool { return v > 5 }).IsSome() {
+		t.Error("expected `iter.Position` to be `None`")
+	} else if iter.RPosition[int](vec.Iter(), func(v int) bool { return v > 5 }).IsSome() {
+		t.Error("expected `iter.RPosition` to be `None`")
+	}
+}
+
+func TestIterAnyAll(t *testing.T) {
+	vec := collections.Vec[int]{2, 4, 5}
+	even := func(v int) bool { return v%2 == 0 }
+
+	if !iter.Any[int](vec.Iter(), even) {
+		t.Error("expected `iter.Any` to be true")
+	} else if iter.All[int](vec.Iter(), even) {
+		t.Error("expected `iter.All` to be false")
+	}
+
+	empty := collections.Vec[int]{}
+	if iter.Any[int](empty.Iter(), even) {
+		t.Error("expected `iter.Any` on an empty iterator to be false")
+	} else if !iter.All[int](empty.Iter(), even) {
+		t.Error("expected `iter.All` on an empty iterator to be true")
+	}
+}
+
+func TestIterFind(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+	values := vec.Iter()
+
+	if iter.Find[int](values, func(v int) bool { return v%2 == 0 }).Unwrap() != 2 {
+		t.Error("expected `iter.Find` to be `Some(2)`")
+	} else if values.Next().Unwrap() != 3 {
+		t.Error("expected `iter.Find` to stop after the first match")
+	} else if iter.Find[int](values, func(v int) bool { return v > 5 }).IsSome() {
+		t.Error("expected `iter.Find` to be `None`")
+	}
+}
+
+func TestIterFindMap(t *testing.T) {
+	vec := collections.Vec[string]{"a", "12", "b", "3"}
+	parse := func(v string) option.Option[int] {
+		if n, err := strconv.Atoi(v); err == nil {
+			return option.Some(n)
+		}
+
+		return option.None[int]()
+	}
+
+	if iter.FindMap[string](vec.Iter(), parse).Unwrap() != 12 {
+		t.Error("expected `iter.FindMap` to be `Some(12)`")
+	}
+}
+
+func TestIterSumProduct(t *testing.T) {
+	vec := collections.Vec[float64]{1.5, 2, 4}
+	empty := collections.Vec[int]{}
+
+	if sum := iter.Sum[float64](vec.Iter()); sum != 7.5 {
+		t.Errorf("expected `iter.Sum` to be 7.5 but got %f", sum)
+	} else if product := iter.Product[float64](vec.Iter()); product != 12 {
+		t.Errorf("expected `iter.Product` to be 12 but got %f", product)
+	} else if iter.Sum[int](empty.Iter()) != 0 {
+		t.Error("expected `iter.Sum` on an empty iterator to be 0")
+	} else if iter.Product[int](empty.Iter()) != 1 {
+		t.Error("expected `iter.Product` on an empty iterator to be 1")
+	}
+}
+
+func TestIterMinMax(t *testing.T) {
+	vec := collections.Vec[int]{3, 1, 4, 1, 5}
+	empty := collections.Vec[int]{}
+
+	if iter.Min[int](vec.Iter()).Unwrap() != 1 {
+		t.Error("expected `iter.Min` to be `Some(1)`")
+	} else if iter.Max[int](vec.Iter()).Unwrap() != 5 {
+		t.Error("expected `iter.Max` to be `Some(5)`")
+	} else if iter.Min[int](empty.Iter()).IsSome() {
+		t.Error("expected `iter.Min` on an empty iterator to be `None`")
+	} else if iter.Max[int](empty.Iter()).IsSome() {
+		t.Error("expected `iter.Max` on an empty iterator to be `None`")
+	}
+
+	pair := iter.MinMax[int](vec.Iter())
+	if pair.Unwrap().First != 1 || pair.Unwrap().Second != 5 {
+		t.Errorf("expected `iter.MinMax` to be (1, 5) but got (%d, %d)", pair.Unwrap().First, pair.Unwrap().Second)
+	} else if iter.MinMax[int](empty.Iter()).IsSome() {
+		t.Error("expected `iter.MinMax` on an empty iterator to be `None`")
+	}
+}
+
+func TestIterMinMaxNaN(t *testing.T) {
+	vec := collections.Vec[float64]{1, math.NaN(), 0.5}
+	identity := func(v float64) float64 { return v }
+
+	// NaN is ordered before every other value, consistently across all of the aggregations
+	pair := iter.MinMax[float64](vec.Iter()).Unwrap()
+	if min := iter.Min[float64](vec.Iter()).Unwrap(); !math.IsNaN(min) {
+		t.Errorf("expected `iter.Min` to be `Some(NaN)` but got `Some(%f)`", min)
+	} else if max := iter.Max[float64](vec.Iter()).Unwrap(); max != 1 {
+		t.Errorf("expected `iter.Max` to be `Some(1)` but got `Some(%f)`", max)
+	} else if !math.IsNaN(pair.First) || pair.Second != 1 {
+		t.Errorf("expected `iter.MinMax` to be (NaN, 1) but got (%f, %f)", pair.First, pair.Second)
+	} else if min := iter.MinByKey[float64](vec.Iter(), identity).Unwrap(); !math.IsNaN(min) {
+		t.Errorf("expected `iter.MinByKey` to be `Some(NaN)` but got `Some(%f)`", min)
+	} else if max := iter.MaxByKey[float64](vec.Iter(), identity).Unwrap(); max != 1 {
+		t.Errorf("expected `iter.MaxByKey` to be `Some(1)` but got `Some(%f)`", max)
+	}
+}
+
+func TestIterMinMaxBy(t *testing.T) {
+	vec := collections.Vec[string]{"bb", "a", "cc", "d"}
+	byLength := func(a, b string) int { return len(a) - len(b) }
+
+	// On ties, Min returns the first element and Max returns the last one
+	if min := iter.MinBy[string](vec.Iter(), byLength).Unwrap(); min != "a" {
+		t.Errorf("expected `iter.MinBy` to be `Some(a)` but got `Some(%s)`", min)
+	} else if max := iter.MaxBy[string](vec.Iter(), byLength).Unwrap(); max != "cc" {
+		t.Errorf("expected `iter.MaxBy` to be `Some(cc)` but got `Some(%s)`", max)
+	} else if min := iter.MinByKey[string](vec.Iter(), func(v string) int { return len(v) }).Unwrap(); min != "a" {
+		t.Errorf("expected `iter.MinByKey` to be `Some(a)` but got `Some(%s)`", min)
+	} else if max := iter.MaxByKey[string](vec.Iter(), func(v string) int { return len(v) }).Unwrap(); max != "cc" {
+		t.Errorf("expected `iter.MaxByKey` to be `Some(cc)` but got `Some(%s)`", max)
+	}
+}
+
+// Parses a string into a result, failing with a TestError holding the length of the string.
+func parseResult(v string) result.Result[int, TestError] {
+	if n, err := strconv.Atoi(v); err == nil {
+		return result.Ok[int, TestError](n)
+	}
+
+	return result.Err[int](TestError{Value: len(v)})
+}
+
+func TestIterCollectResult(t *testing.T) {
+	vec := collections.Vec[string]{"1", "2", "3"}
+	collected := iter.CollectResult(iter.Map[string](vec.Iter(), parseResult))
+	parsed := collected.Unwrap()
+	ExpectValues[int](t, "iter.CollectResult", parsed.Iter(), []int{1, 2, 3})
+
+	vec = collections.Vec[string]{"1", "two", "3", "four"}
+	values := vec.Iter()
+	collected = iter.CollectResult(iter.Map[string](values, parseResult))
+
+	if err := collected.UnwrapErr(); err.Value != 3 {
+		t.Errorf("expected `iter.CollectResult` to be `Err(3)` but got `Err(%d)`", err.Value)
+	} else if values.Next().Unwrap() != "3" {
+		t.Error("expected `iter.CollectResult` to stop at the first error")
+	}
+}
+
+func TestIterCollectOption(t *testing.T) {
+	vec := collections.Vec[option.Option[int]]{option.Some(1), option.Some(2)}
+	collected := iter.CollectOption[int](vec.Iter()).Unwrap()
+	ExpectValues[int](t, "iter.CollectOption", collected.Iter(), []int{1, 2})
+
+	vec = collections.Vec[option.Option[int]]{option.Some(1), option.None[int](), option.Some(3)}
+	values := vec.Iter()
+
+	if iter.CollectOption[int](values).IsSome() {
+		t.Error("expected `iter.CollectOption` to be `None`")
+	} else if values.Next().Unwrap().Unwrap() != 3 {
+		t.Error("expected `iter.CollectOption` to stop at the first None")
+	}
+}
+
+func TestIterTryFold(t *testing.T) {
+	sum := func(acc int, v string) result.Result[int, TestError] {
+		return result.AndThen(parseResult(v), func(n *int) result.Result[int, TestError] {
+			return result.Ok[int, TestError](acc + *n)
+		})
+	}
+
+	vec := collections.Vec[string]{"1", "2", "3"}
+	if total := iter.TryFold[string](vec.Iter(), 0, sum).Unwrap(); total != 6 {
+		t.Errorf("expected `iter.TryFold` to be `Ok(6)` but got `Ok(%d)`", total)
+	}
+
+	vec = collections.Vec[string]{"1", "two", "3"}
+	if err := iter.TryFold[string](vec.Iter(), 0, sum).UnwrapErr(); err.Value != 3 {
+		t.Errorf("expected `iter.TryFold` to be `Err(3)` but got `Err(%d)`", err.Value)
+	}
+}
+
+func TestIterTryForEach(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+	visited := collections.Vec[int]{}
+
+	res := iter.TryForEach[int](vec.Iter(), func(v int) result.Result[struct{}, TestError] {
+		if v == 3 {
+			return result.Err[struct{}](TestError{Value: v})
+		}
+
+		visited.Push(v)
+		return result.Ok[struct{}, TestError](struct{}{})
+	})
+
+	if res.UnwrapErr().Value != 3 {
+		t.Errorf("expected `iter.TryForEach` to be `Err(3)` but got `Err(%d)`", res.UnwrapErr().Value)
+	}
+
+	ExpectValues[int](t, "visited", visited.Iter(), []int{1, 2})
+}
+
+func TestIterSizeHint(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4, 5, 6, 7}
+	isEven := func(v int) bool { return v%2 == 0 }
+	hints := []struct {
+		name  string
+		it    collections.Iterator[int]
+		lower int
+		upper option.Option[int]
+	}{
+		{"iter.Map", iter.Map[int](vec.Iter(), func(v int) int { return v }), 7, option.Some(7)},
+		{"iter.Filter", iter.Filter[int](vec.Iter(), isEven), 0, option.Some(7)},
+		{"iter.Chain", iter.Chain[int](vec.Iter(), vec.Iter()), 14, option.Some(14)},
+		{"iter.Chain(infinite)", iter.Chain(vec.Iter(), iter.Repeat(0)), math.MaxInt, option.None[int]()},
+		{"iter.Take(iter.Chain(infinite))", iter.Take(iter.Chain(vec.Iter(), iter.Repeat(0)), 10), 10, option.Some(10)},
+		{"iter.Take", iter.Take[int](vec.Iter(), 3), 3, option.Some(3)},
+		{"iter.Skip", iter.Skip[int](vec.Iter(), 3), 4, option.Some(4)},
+		{"iter.Skip", iter.Skip[int](vec.Iter(), 10), 0, option.Some(0)},
+		{"iter.StepBy", iter.StepBy[int](vec.Iter(), 3), 3, option.Some(3)},
+		{"iter.TakeWhile", iter.TakeWhile[int](vec.Iter(), isEven), 0, option.Some(7)},
+		{"iter.Cycle", iter.Cycle[int](&flickeringIter{}), 0, option.None[int]()},
+		{"iter.Rev", iter.Rev[int](vec.Iter()), 7, option.Some(7)},
+	}
+
+	for _, hint := range hints {
+		lower, upper := collections.SizeHint(hint.it)
+		if lower != hint.lower || upper.IsSome() != hint.upper.IsSome() || upper.UnwrapOrDefault() != hint.upper.UnwrapOrDefault() {
+			t.Errorf("expected `%s` size hint to be (%d, %v) but got (%d, %v)", hint.name, hint.lower, hint.upper, lower, upper)
+		}
+	}
+
+	zipped := iter.Zip[int, int](vec.Iter(), iter.Take[int](vec.Iter(), 2))
+	if lower, upper := collections.SizeHint(zipped); lower != 2 || upper.Unwrap() != 2 {
+		t.Errorf("expected `iter.Zip` size hint to be (2, Some(2)) but got (%d, %v)", lower, upper)
+	}
+}
+
+func TestIterRev(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	ExpectValues[int](t, "iter.Rev", iter.Rev[int](vec.Iter()), []int{3, 2, 1})
+	ExpectValues[int](t, "iter.Rev", iter.Rev(iter.Rev[int](vec.Iter())), []int{1, 2, 3})
+}
+
+func TestIterRFindRFold(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+
+	if iter.RFind[int](vec.Iter(), func(v int) bool { return v%2 == 1 }).Unwrap() != 3 {
+		t.Error("expected `iter.RFind` to be `Some(3)`")
+	}
+
+	joined := iter.RFold[int](vec.Iter(), "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	if joined != "4321" {
+		t.Errorf("expected `iter.RFold` to be \"4321\" but got %q", joined)
+	}
+}
+
+func TestIterNthBack(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+	values := vec.Iter()
+
+	if iter.NthBack[int](values, 1).Unwrap() != 3 {
+		t.Error("expected `iter.NthBack(values, 1)` to be `Some(3)`")
+	} else if values.Next().Unwrap() != 1 {
+		t.Error("expected `values.Next()` to be `Some(1)`")
+	} else if iter.NthBack[int](values, 1).IsSome() {
+		t.Error("expected `iter.NthBack(values, 1)` to be `None`")
+	}
+}
+
+func TestIterRPositionShortCircuits(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 2, 1}
+	values := vec.Iter()
+
+	if iter.RPosition[int](values, func(v int) bool { return v == 2 }).Unwrap() != 3 {
+		t.Error("expected `iter.RPosition` to be `Some(3)`")
+	} else if values.Len() != 3 {
+		t.Errorf("expected `iter.RPosition` to leave 3 elements but left %d", values.Len())
+	}
+}
+
+func TestIterChunkBy(t *testing.T) {
+	vec := collections.Vec[int]{1, 3, 2, 4, 6, 5}
+	groups := collections.CollectVec(iter.ChunkBy[int](vec.Iter(), func(v int) bool { return v%2 == 0 }))
+
+	expectedKeys := []bool{false, true, false}
+	expectedGroups := [][]int{{1, 3}, {2, 4, 6}, {5}}
+	if groups.Len() != len(expectedGroups) {
+		t.Fatalf("expected `iter.ChunkBy` to yield %d groups but got %d", len(expectedGroups), groups.Len())
+	}
+
+	for i, group := range groups {
+		if group.First != expectedKeys[i] || !slices.Equal(group.Second, expectedGroups[i]) {
+			t.Errorf("expected group %d to be (%v, %v) but got (%v, %v)", i, expectedKeys[i], expectedGroups[i], group.First, group.Second)
+		}
+	}
+}
+
+func TestIterChunkByKeyCalls(t *testing.T) {
+	calls := 0
+	vec := collections.Vec[int]{1, 1, 2, 2, 3}
+	groups := iter.Count(iter.ChunkBy[int](vec.Iter(), func(v int) int { calls++; return v }))
+
+	if groups != 3 {
+		t.Errorf("expected `iter.ChunkBy` to yield 3 groups but got %d", groups)
+	} else if calls != vec.Len() {
+		t.Errorf("expected the key to be called %d times but got %d", vec.Len(), calls)
+	}
+}
+
+func vecEqual(vec collections.Vec[int], expected []int) bool {
+	return slices.Equal(vec, expected)
+}
+
+func TestIterChunks(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4, 5}
+	chunks := collections.CollectVec(iter.Chunks[int](vec.Iter(), 2))
+
+	expectedChunks := [][]int{{1, 2}, {3, 4}, {5}}
+	if !slices.EqualFunc(chunks, expectedChunks, vecEqual) {
+		t.Errorf("expected `iter.Chunks` to yield %v but got %v", expectedChunks, chunks)
+	}
+
+	if lower, upper := collections.SizeHint(iter.Chunks[int](vec.Iter(), 2)); lower != 3 || upper.Unwrap() != 3 {
+		t.Errorf("expected `iter.Chunks` size hint to be (3, Some(3)) but got (%d, %v)", lower, upper)
+	}
+
+	if lower, _ := collections.SizeHint(iter.Chunks(iter.Repeat(0), 2)); lower != math.MaxInt/2+1 {
+		t.Errorf("expected `iter.Chunks(iter.Repeat)` lower bound to be %d but got %d", math.MaxInt/2+1, lower)
+	}
+}
+
+func TestIterChunksPanic(t *testing.T) {
+	defer ShouldPanic(t)
+
+	vec := collections.Vec[int]{}
+	iter.Chunks[int](vec.Iter(), 0)
+}
+
+func TestIterWindows(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+	windows := collections.CollectVec(iter.Windows[int](vec.Iter(), 3))
+
+	expectedWindows := [][]int{{1, 2, 3}, {2, 3, 4}}
+	if !slices.EqualFunc(windows, expectedWindows, vecEqual) {
+		t.Errorf("expected `iter.Windows` to yield %v but got %v", expectedWindows, windows)
+	}
+
+	short := collections.Vec[int]{1, 2}
+	if iter.Windows[int](short.Iter(), 3).Next().IsSome() {
+		t.Error("expected `iter.Windows` on a short iterator to be empty")
+	}
+}
+
+func TestIterInterleave(t *testing.T) {
+	a := collections.Vec[int]{1, 3, 5, 7}
+	b := collections.Vec[int]{2, 4}
+	ExpectValues(t, "iter.Interleave", iter.Interleave[int](a.Iter(), b.Iter()), []int{1, 2, 3, 4, 5, 7})
+	ExpectValues(t, "iter.Interleave", iter.Interleave[int](b.Iter(), a.Iter()), []int{2, 1, 4, 3, 5, 7})
+}
+
+func TestIterIntersperse(t *testing.T) {
+	vec := collections.Vec[string]{"a", "b", "c"}
+	ExpectValues(t, "iter.Intersperse", iter.Intersperse[string](vec.Iter(), ","), []string{"a", ",", "b", ",", "c"})
+
+	empty := collections.Vec[string]{}
+	ExpectValues(t, "iter.Intersperse", iter.Intersperse[string](empty.Iter(), ","), []string{})
+}
+
+func TestIterUnique(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 1, 3, 2, 4}
+	ExpectValues(t, "iter.Unique", iter.Unique[int](vec.Iter()), []int{1, 2, 3, 4})
+
+	words := collections.Vec[string]{"apple", "avocado", "banana", "blueberry", "cherry"}
+	firstLetter := func(v string) byte { return v[0] }
+	ExpectValues(t, "iter.UniqueBy", iter.UniqueBy[string](words.Iter(), firstLetter), []string{"apple", "banana", "cherry"})
+}
+
+func TestIterDedup(t *testing.T) {
+	vec := collections.Vec[int]{1, 1, 2, 2, 2, 1, 3, 3}
+	ExpectValues(t, "iter.Dedup", iter.Dedup[int](vec.Iter()), []int{1, 2, 1, 3})
+
+	// The elements are compared to the previously yielded one, so this keeps every element which is at least 2 bigger than it
+	close := func(a, b int) bool { return a-b < 2 }
+	increasing := collections.Vec[int]{1, 2, 3, 4, 5, 8}
+	ExpectValues(t, "iter.DedupBy", iter.DedupBy[int](increasing.Iter(), close), []int{1, 3, 5, 8})
+}
+
+// Consumes an iterator of vectors and checks it yielded exactly the expected vectors, in order.
+func expectVecs(t *testing.T, name string, it collections.Iterator[collections.Vec[int]], expected [][]int) {
+	t.Helper()
+
+	if vecs := collections.CollectVec(it); !slices.EqualFunc(vecs, expected, vecEqual) {
+		t.Errorf("expected `%s` to yield %v but got %v", name, expected, vecs)
+	}
+}
+
+func TestIterCartesianProduct(t *testing.T) {
+	a := collections.Vec[int]{1, 2}
+	b := collections.Vec[int]{3}
+	c := collections.Vec[int]{4, 5}
+
+	expectVecs(t, "iter.CartesianProduct", iter.CartesianProduct(a, b, c), [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}})
+	expectVecs(t, "iter.CartesianProduct", iter.CartesianProduct(a, collections.Vec[int]{}), [][]int{})
+	expectVecs(t, "iter.CartesianProduct", iter.CartesianProduct[int](), [][]int{{}})
+}
+
+func TestIterCombinations(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+
+	expectVecs(t, "iter.Combinations", iter.Combinations(vec, 2), [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}})
+	expectVecs(t, "iter.Combinations", iter.Combinations(vec, 4), [][]int{{1, 2, 3, 4}})
+	expectVecs(t, "iter.Combinations", iter.Combinations(vec, 0), [][]int{{}})
+	expectVecs(t, "iter.Combinations", iter.Combinations(vec, 5), [][]int{})
+}
+
+func TestIterCombinationsWithReplacement(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+
+	expectVecs(t, "iter.CombinationsWithReplacement", iter.CombinationsWithReplacement(vec, 2), [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}})
+	expectVecs(t, "iter.CombinationsWithReplacement", iter.CombinationsWithReplacement(collections.Vec[int]{}, 2), [][]int{})
+}
+
+func TestIterPermutations(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+
+	expectVecs(t, "iter.Permutations", iter.Permutations(vec, 3), [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}})
+	expectVecs(t, "iter.Permutations", iter.Permutations(vec, 2), [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}})
+	expectVecs(t, "iter.Permutations", iter.Permutations(vec, 4), [][]int{})
+}
+
+func TestIterPowerset(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	expectVecs(t, "iter.Powerset", iter.Powerset(vec), [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}})
+}
+
+func TestIterCombinatoricsAreLazy(t *testing.T) {
+	// 2^64 subsets, this only terminates if the iterator is lazy
+	vec := sequence(64)
+	ExpectValues(t, "iter.Powerset", iter.Map(iter.Take(iter.Powerset(vec), 3), collections.Vec[int].Len), []int{0, 1, 1})
+}
+
+func TestIterFromFn(t *testing.T) {
+	count := 0
+	counter := iter.FromFn(func() option.Option[int] {
+		if count == 3 {
+			return option.None[int]()
+		}
+
+		count++
+		return option.Some(count)
+	})
+
+	ExpectValues(t, "iter.FromFn", counter, []int{1, 2, 3})
+}
+
+func TestIterSuccessors(t *testing.T) {
+	powers := iter.Successors(option.Some(1), func(v int) option.Option[int] {
+		if v >= 100 {
+			return option.None[int]()
+		}
+
+		return option.Some(v * 10)
+	})
+
+	ExpectValues(t, "iter.Successors", powers, []int{1, 10, 100})
+	ExpectValues(t, "iter.Successors", iter.Successors(option.None[int](), func(v int) option.Option[int] { return option.Some(v) }), []int{})
+}
+
+func TestIterRepeat(t *testing.T) {
+	ExpectValues(t, "iter.Repeat", iter.Take(iter.Repeat("a"), 3), []string{"a", "a", "a"})
+	ExpectValues[string](t, "iter.RepeatN", iter.RepeatN("a", 2), []string{"a", "a"})
+	ExpectValues[string](t, "iter.Once", iter.Once("a"), []string{"a"})
+	ExpectValues[string](t, "iter.Empty", iter.Empty[string](), []string{})
+
+	count := 0
+	ExpectValues(t, "iter.RepeatWith", iter.Take(iter.RepeatWith(func() int { count++; return count }), 3), []int{1, 2, 3})
+
+	if lower, upper := collections.SizeHint(iter.Repeat(1)); lower != math.MaxInt || upper.IsSome() {
+		t.Errorf("expected `iter.Repeat` size hint to be (MaxInt, None) but got (%d, %v)", lower, upper)
+	} else if lower, upper := collections.SizeHint[int](iter.RepeatN(1, 3)); lower != 3 || upper.Unwrap() != 3 {
+		t.Errorf("expected `iter.RepeatN` size hint to be (3, Some(3)) but got (%d, %v)", lower, upper)
+	}
+}
+
+func TestIterRange(t *testing.T) {
+	ExpectValues[int](t, "iter.Range", iter.Range(-2, 3), []int{-2, -1, 0, 1, 2})
+	ExpectValues[int](t, "iter.Range", iter.Range(3, 3), []int{})
+	ExpectValues[int](t, "iter.RangeInclusive", iter.RangeInclusive(1, 3), []int{1, 2, 3})
+	ExpectValues[int](t, "iter.RangeInclusive", iter.RangeInclusive(3, 1), []int{})
+	ExpectValues[uint8](t, "iter.RangeInclusive", iter.Skip[uint8](iter.RangeInclusive[uint8](0, 255), 254), []uint8{254, 255})
+	ExpectValues[int](t, "iter.Range.StepBy", iter.Range(0, 10).StepBy(3), []int{0, 3, 6, 9})
+	ExpectValues[int](t, "iter.Rev(iter.Range.StepBy)", iter.Rev[int](iter.Range(0, 10).StepBy(4)), []int{8, 4, 0})
+	ExpectValues[int8](t, "iter.RangeInclusive.StepBy", iter.RangeInclusive[int8](-128, 127).StepBy(100), []int8{-128, -28, 72})
+
+	if length := iter.RangeInclusive[int8](-128, 127).Len(); length != 256 {
+		t.Errorf("expected `iter.RangeInclusive(-128, 127).Len()` to be 256 but got %d", length)
+	} else if length := iter.Range(0, 10).StepBy(3).Len(); length != 4 {
+		t.Errorf("expected `iter.Range(0, 10).StepBy(3).Len()` to be 4 but got %d", length)
+	}
+
+	wide := iter.RangeInclusive[int64](math.MinInt64, math.MaxInt64)
+	if lower, upper := wide.SizeHint(); lower != math.MaxInt || upper.IsSome() || wide.Len() != math.MaxInt {
+		t.Errorf("expected the size hint of the full int64 range to be (MaxInt, None) but got (%d, %v)", lower, upper)
+	}
+
+	if sum := iter.Sum[int](iter.RangeInclusive(1, 100)); sum != 5050 {
+		t.Errorf("expected the sum of [1, 100] to be 5050 but got %d", sum)
+	}
+}
+
+func TestIterRangeStepByPanic(t *testing.T) {
+	defer ShouldPanic(t)
+
+	iter.Range(0, 10).StepBy(0)
+}
+
+func TestIterPeekable(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	peekable := iter.Peekable[int](vec.Iter())
+
+	if peekable.Peek().Unwrap() != 1 || peekable.Peek().Unwrap() != 1 {
+		t.Error("expected `peekable.Peek()` to be `Some(1)` without consuming it")
+	} else if lower, upper := collections.SizeHint[int](peekable); lower != 3 || upper.Unwrap() != 3 {
+		t.Errorf("expected `peekable` size hint to be (3, Some(3)) but got (%d, %v)", lower, upper)
+	} else if peekable.Next().Unwrap() != 1 {
+		t.Error("expected `peekable.Next()` to be `Some(1)`")
+	} else if peekable.NextIf(func(v int) bool { return v > 2 }).IsSome() {
+		t.Error("expected `peekable.NextIf(v > 2)` to be `None`")
+	} else if peekable.NextIf(func(v int) bool { return v == 2 }).Unwrap() != 2 {
+		t.Error("expected `peekable.NextIf(v == 2)` to be `Some(2)`")
+	} else if iter.NextIfEq(peekable, 4).IsSome() {
+		t.Error("expected `iter.NextIfEq(peekable, 4)` to be `None`")
+	} else if iter.NextIfEq(peekable, 3).Unwrap() != 3 {
+		t.Error("expected `iter.NextIfEq(peekable, 3)` to be `Some(3)`")
+	} else if peekable.Peek().IsSome() || peekable.Next().IsSome() {
+		t.Error("expected `peekable` to be exhausted")
+	}
+}
+
+func TestIterPeekableInfiniteSizeHint(t *testing.T) {
+	peekable := iter.Peekable(iter.Repeat(0))
+	peekable.Peek()
+
+	putBack := iter.PutBack(iter.Repeat(0))
+	putBack.PutBack(1)
+
+	for name, it := range map[string]collections.Iterator[int]{"iter.Peekable": peekable, "iter.PutBack": putBack} {
+		if lower, upper := collections.SizeHint(it); lower != math.MaxInt || upper.IsSome() {
+			t.Errorf("expected `%s(iter.Repeat)` size hint to be (MaxInt, None) but got (%d, %v)", name, lower, upper)
+		}
+	}
+}
+
+func TestIterPeekableTokenizer(t *testing.T) {
+	// Groups consecutive digits into numbers, a typical use of NextIf
+	input := collections.Vec[rune]([]rune("12+345"))
+	chars := iter.Peekable[rune](input.Iter())
+	tokens := []string{}
+
+	isDigit := func(r rune) bool { return r >= '0' && r <= '9' }
+	for char := chars.Next(); char.IsSome(); char = chars.Next() {
+		token := []rune{char.Unwrap()}
+		if isDigit(char.Unwrap()) {
+			for digit := chars.NextIf(isDigit); digit.IsSome(); digit = chars.NextIf(isDigit) {
+				token = append(token, digit.Unwrap())
+			}
+		}
+
+		tokens = append(tokens, string(token))
+	}
+
+	if !slices.Equal(tokens, []string{"12", "+", "345"}) {
+		t.Errorf("expected the tokens to be [12 + 345] but got %v", tokens)
+	}
+}
+
+func TestIterPutBack(t *testing.T) {
+	vec := collections.Vec[int]{1, 2}
+	putBack := iter.PutBack[int](vec.Iter())
+
+	first := putBack.Next().Unwrap()
+	putBack.PutBack(first)
+	putBack.PutBack(0)
+
+	if lower, upper := collections.SizeHint[int](putBack); lower != 3 || upper.Unwrap() != 3 {
+		t.Errorf("expected `putBack` size hint to be (3, Some(3)) but got (%d, %v)", lower, upper)
+	}
+
+	ExpectValues[int](t, "iter.PutBack", putBack, []int{0, 1, 2})
+}
+
+func TestIterMultiPeek(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	multiPeek := iter.MultiPeek[int](vec.Iter())
+
+	if multiPeek.Peek().Unwrap() != 1 || multiPeek.Peek().Unwrap() != 2 {
+		t.Error("expected `multiPeek.Peek()` to be `Some(1)` and then `Some(2)`")
+	}
+
+	multiPeek.ResetPeek()
+	if multiPeek.Peek().Unwrap() != 1 {
+		t.Error("expected `multiPeek.Peek()` to be `Some(1)` after `multiPeek.ResetPeek()`")
+	} else if multiPeek.Next().Unwrap() != 1 {
+		t.Error("expected `multiPeek.Next()` to be `Some(1)`")
+	} else if multiPeek.Peek().Unwrap() != 2 || multiPeek.Peek().Unwrap() != 3 || multiPeek.Peek().IsSome() {
+		t.Error("expected `multiPeek.Peek()` to be `Some(2)`, `Some(3)` and then `None`")
+	}
+
+	ExpectValues[int](t, "iter.MultiPeek", multiPeek, []int{2, 3})
+}
+
+func TestIterMerge(t *testing.T) {
+	a := collections.Vec[int]{1, 3, 5, 7}
+	b := collections.Vec[int]{2, 3, 4}
+	ExpectValues(t, "iter.Merge", iter.Merge[int](a.Iter(), b.Iter()), []int{1, 2, 3, 3, 4, 5, 7})
+
+	// Sorted by length, on ties the elements of the first iterator come first
+	words := collections.Vec[string]{"a", "bb", "ccc"}
+	others := collections.Vec[string]{"x", "yy"}
+	byLength := func(a, b string) int { return len(a) - len(b) }
+	ExpectValues(t, "iter.MergeBy", iter.MergeBy[string](words.Iter(), others.Iter(), byLength), []string{"a", "x", "bb", "yy", "ccc"})
+}
+
+func TestIterKMerge(t *testing.T) {
+	a := collections.Vec[int]{1, 4, 7}
+	b := collections.Vec[int]{2, 5, 8}
+	c := collections.Vec[int]{0, 3, 6, 9}
+	empty := collections.Vec[int]{}
+
+	merged := iter.KMerge[int](a.Iter(), empty.Iter(), b.Iter(), c.Iter())
+	if lower, upper := collections.SizeHint(merged); lower != 10 || upper.Unwrap() != 10 {
+		t.Errorf("expected `iter.KMerge` size hint to be (10, Some(10)) but got (%d, %v)", lower, upper)
+	}
+
+	ExpectValues(t, "iter.KMerge", merged, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+	ExpectValues(t, "iter.KMerge", iter.KMerge[int](), []int{})
+
+	infinite := iter.KMerge(a.Iter(), iter.Repeat(10), iter.Repeat(11))
+	if lower, upper := collections.SizeHint(infinite); lower != math.MaxInt || upper.IsSome() {
+		t.Errorf("expected `iter.KMerge(iter.Repeat)` size hint to be (MaxInt, None) but got (%d, %v)", lower, upper)
+	}
+
+	// Descending order, on ties the elements of the earlier iterators come first
+	type record struct {
+		key    int
+		source string
+	}
+
+	first := collections.Vec[record]{{2, "first"}, {1, "first"}}
+	second := collections.Vec[record]{{2, "second"}, {0, "second"}}
+	descending := func(a, b record) int { return b.key - a.key }
+	ExpectValues(t, "iter.KMergeBy", iter.KMergeBy[record](descending, first.Iter(), second.Iter()), []record{{2, "first"}, {2, "second"}, {1, "first"}, {0, "second"}})
+}
+
+func TestIterMergeJoinBy(t *testing.T) {
+	left := collections.Vec[int]{1, 2, 4}
+	right := collections.Vec[string]{"2", "3", "4", "5"}
+	compare := func(l int, r string) int {
+		n, _ := strconv.Atoi(r)
+		return l - n
+	}
+
+	joined := collections.CollectVec(iter.MergeJoinBy[int, string](left.Iter(), right.Iter(), compare))
+	expected := []string{"L1", "B2=2", "R3", "B4=4", "R5"}
+	if joined.Len() != len(expected) {
+		t.Fatalf("expected `iter.MergeJoinBy` to yield %d elements but got %d", len(expected), joined.Len())
+	}
+
+	for i, item := range joined {
+		var got string
+		switch {
+		case item.IsLeft():
+			got = "L" + strconv.Itoa(item.Left.Unwrap())
+		case item.IsRight():
+			got = "R" + item.Right.Unwrap()
+		case item.IsBoth():
+			got = "B" + strconv.Itoa(item.Left.Unwrap()) + "=" + item.Right.Unwrap()
+		}
+
+		if got != expected[i] {
+			t.Errorf("expected `iter.MergeJoinBy` element %d to be %s but got %s", i, expected[i], got)
+		}
+	}
+}
+
+func TestIterGroupBy(t *testing.T) {
+	words := collections.Vec[string]{"apple", "bob", "avocado", "cherry", "banana"}
+	groups := iter.GroupBy[string](words.Iter(), func(v string) byte { return v[0] })
+
+	expectedGroups := map[byte][]string{'a': {"apple", "avocado"}, 'b': {"bob", "banana"}, 'c': {"cherry"}}
+	if !maps.EqualFunc(groups, expectedGroups, func(group collections.Vec[string], expected []string) bool { return slices.Equal(group, expected) }) {
+		t.Errorf("expected `iter.GroupBy` to be %v but got %v", expectedGroups, groups)
+	}
+}
+
+func TestIterCountBy(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4, 5}
+	counts := iter.CountBy[int](vec.Iter(), func(v int) bool { return v%2 == 0 })
+
+	if !maps.Equal(counts, collections.Map[bool, int]{true: 2, false: 3}) {
+		t.Errorf("expected `iter.CountBy` to be {true: 2, false: 3} but got %v", counts)
+	}
+}
+
+func TestIterIndexBy(t *testing.T) {
+	words := collections.Vec[string]{"apple", "bob", "cherry"}
+	index := iter.IndexBy[string](words.Iter(), func(v string) byte { return v[0] })
+
+	if !maps.Equal(index.Unwrap(), collections.Map[byte, string]{'a': "apple", 'b': "bob", 'c': "cherry"}) {
+		t.Errorf("expected `iter.IndexBy` to be {a: apple, b: bob, c: cherry} but got %v", index.Unwrap())
+	}
+
+	words = collections.Vec[string]{"apple", "bob", "avocado"}
+	if err := iter.IndexBy[string](words.Iter(), func(v string) byte { return v[0] }).UnwrapErr(); err.Key != 'a' {
+		t.Errorf("expected `iter.IndexBy` to fail on the key 'a' but got %q", err.Key)
+	} else if err.Error() != "duplicate key: 97" {
+		t.Errorf("expected the error message to be \"duplicate key: 97\" but got %q", err.Error())
+	}
+}
+
+func TestIterPartition(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4, 5}
+	partition := iter.Partition[int](vec.Iter(), func(v int) bool { return v%2 == 0 })
+
+	if !slices.Equal(partition.First, []int{2, 4}) || !slices.Equal(partition.Second, []int{1, 3, 5}) {
+		t.Errorf("expected `iter.Partition` to be ([2 4], [1 3 5]) but got (%v, %v)", partition.First, partition.Second)
+	}
+}
+
+func TestIterUnzip(t *testing.T) {
+	pairs := collections.Vec[collections.Pair[int, string]]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}
+	unzipped := iter.Unzip[int, string](pairs.Iter())
+
+	if !slices.Equal(unzipped.First, []int{1, 2}) || !slices.Equal(unzipped.Second, []string{"a", "b"}) {
+		t.Errorf("expected `iter.Unzip` to be ([1 2], [a b]) but got (%v, %v)", unzipped.First, unzipped.Second)
+	}
+}
+
+// An iterator with a broken size hint, reporting a negative lower bound.
+type negativeHintIter struct {
+	collections.Iterator[collections.Pair[int, int]]
+}
+
+func (negativeHintIter) SizeHint() (int, option.Option[int]) {
+	return -1, option.None[int]()
+}
+
+func TestIterUnzipSizeHint(t *testing.T) {
+	vec := collections.Vec[int]{1, 2}
+	unzipped := iter.Unzip(iter.Take(iter.Enumerate(iter.Chain(vec.Iter(), iter.Repeat(0))), 5))
+
+	if !slices.Equal(unzipped.First, []int{0, 1, 2, 3, 4}) || !slices.Equal(unzipped.Second, []int{1, 2, 0, 0, 0}) {
+		t.Errorf("expected `iter.Unzip` to be ([0 1 2 3 4], [1 2 0 0 0]) but got (%v, %v)", unzipped.First, unzipped.Second)
+	}
+
+	pairs := collections.Vec[collections.Pair[int, int]]{{First: 1, Second: 2}}
+	unzipped = iter.Unzip[int, int](negativeHintIter{pairs.Iter()})
+	if !slices.Equal(unzipped.First, []int{1}) || !slices.Equal(unzipped.Second, []int{2}) {
+		t.Errorf("expected `iter.Unzip` to be ([1], [2]) but got (%v, %v)", unzipped.First, unzipped.Second)
+	}
+}
+
+func TestIterEqual(t *testing.T) {
+	a := collections.Vec[int]{1, 2, 3}
+	b := collections.Vec[int]{1, 2}
+
+	if !iter.Equal[int](a.Iter(), a.Iter()) {
+		t.Error("expected `iter.Equal(a, a)` to be true")
+	} else if iter.Equal[int](a.Iter(), b.Iter()) {
+		t.Error("expected `iter.Equal(a, b)` to be false")
+	}
+
+	words := collections.Vec[string]{"1", "2", "3"}
+	if !iter.EqualBy[int, string](a.Iter(), words.Iter(), func(v int, w string) bool { return strconv.Itoa(v) == w }) {
+		t.Error("expected `iter.EqualBy(a, words)` to be true")
+	}
+}
+
+func TestIterCmp(t *testing.T) {
+	a := collections.Vec[int]{1, 2, 3}
+	b := collections.Vec[int]{1, 2}
+	c := collections.Vec[int]{1, 3}
+
+	if ordering := iter.Cmp[int](a.Iter(), a.Iter()); ordering != num.Equal {
+		t.Errorf("expected `iter.Cmp(a, a)` to be Equal but got %s", ordering)
+	} else if ordering := iter.Cmp[int](b.Iter(), a.Iter()); ordering != num.Less {
+		t.Errorf("expected `iter.Cmp(b, a)` to be Less but got %s", ordering)
+	} else if ordering := iter.Cmp[int](c.Iter(), a.Iter()); ordering != num.Greater {
+		t.Errorf("expected `iter.Cmp(c, a)` to be Greater but got %s", ordering)
+	}
+
+	descending := func(a, b int) int { return b - a }
+	if ordering := iter.CmpBy[int, int](c.Iter(), a.Iter(), descending); ordering != num.Less {
+		t.Errorf("expected `iter.CmpBy(c, a, descending)` to be Less but got %s", ordering)
+	}
+}
+
+func TestIterPartialCmp(t *testing.T) {
+	a := collections.Vec[float64]{1, math.NaN()}
+	b := collections.Vec[float64]{1, 2}
+	c := collections.Vec[float64]{0, math.NaN()}
+
+	if iter.PartialCmp[float64](a.Iter(), b.Iter()).IsSome() {
+		t.Error("expected `iter.PartialCmp(a, b)` to be None")
+	} else if ordering := iter.PartialCmp[float64](c.Iter(), a.Iter()); ordering.Unwrap() != num.Less {
+		t.Errorf("expected `iter.PartialCmp(c, a)` to be Some(Less) but got %v", ordering)
+	}
+
+	// Unlike PartialCmp, Cmp uses a total order where NaN is equal to itself and less than any other value
+	if ordering := iter.Cmp[float64](a.Iter(), a.Iter()); ordering != num.Equal {
+		t.Errorf("expected `iter.Cmp(a, a)` to be Equal but got %s", ordering)
+	} else if ordering := iter.Cmp[float64](a.Iter(), b.Iter()); ordering != num.Less {
+		t.Errorf("expected `iter.Cmp(a, b)` to be Less but got %s", ordering)
+	}
+}
+
+func TestIterIsSorted(t *testing.T) {
+	sorted := collections.Vec[int]{1, 2, 2, 5}
+	unsorted := collections.Vec[int]{1, 3, 2}
+	empty := collections.Vec[int]{}
+
+	if !iter.IsSorted[int](sorted.Iter()) || !iter.IsSorted[int](empty.Iter()) {
+		t.Error("expected `iter.IsSorted` to be true")
+	} else if iter.IsSorted[int](unsorted.Iter()) {
+		t.Error("expected `iter.IsSorted(unsorted)` to be false")
+	} else if !iter.IsSortedBy[int](iter.Rev[int](sorted.Iter()), func(a, b int) int { return b - a }) {
+		t.Error("expected `iter.IsSortedBy(descending)` to be true")
+	}
+
+	words := collections.Vec[string]{"c", "bb", "aaa"}
+	if !iter.IsSortedByKey[string](words.Iter(), func(v string) int { return len(v) }) {
+		t.Error("expected `iter.IsSortedByKey(length)` to be true")
+	}
+
+	floats := collections.Vec[float64]{math.NaN(), 1}
+	if iter.IsSorted[float64](floats.Iter()) {
+		t.Error("expected an iterator containing NaN to not be sorted")
+	}
+}
+
+func TestIterTee(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+	tees := iter.Tee[int](vec.Iter(), 2)
+
+	if tees.Len() != 2 {
+		t.Fatalf("expected 2 iterators but got %d", tees.Len())
+	}
+
+	// Interleave the iterators unevenly, both must still see every element
+	first, second := tees[0], tees[1]
+	if value := first.Next(); value.Unwrap() != 1 {
+		t.Errorf("expected the first element to be 1 but got %v", value)
+	} else if lower, _ := second.SizeHint(); lower != 4 {
+		t.Errorf("expected the second iterator to have 4 elements left but got %d", lower)
+	}
+
+	ExpectValues[int](t, "Tee(second)", second, []int{1, 2, 3, 4})
+	ExpectValues[int](t, "Tee(first)", first, []int{2, 3, 4})
+}
+
+func TestIterTeeLazy(t *testing.T) {
+	pulled := 0
+	vec := collections.Vec[int]{1, 2, 3}
+	tees := iter.Tee(iter.Inspect[int](vec.Iter(), func(int) { pulled++ }), 3)
+
+	tees[0].Next()
+	tees[1].Next()
+	tees[2].Next()
+	tees[0].Next()
+
+	if pulled != 2 {
+		t.Errorf("expected 2 elements to be pulled but got %d", pulled)
+	}
+}
+
+func TestIterTeeSequential(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tees := iter.Tee[int](vec.Iter(), 2)
+
+	// Draining one iterator before the other on a single goroutine must not block
+	if sum := iter.Sum[int](tees[0]); sum != 55 {
+		t.Errorf("expected the sum of the first iterator to be 55 but got %d", sum)
+	} else if count := iter.Count[int](tees[1]); count != 10 {
+		t.Errorf("expected the count of the second iterator to be 10 but got %d", count)
+	}
+}
+
+func TestIterTeeConcurrent(t *testing.T) {
+	tees := iter.TeeBounded(iter.Range(0, 1000), 2, 8)
+	sums := make(chan int, tees.Len())
+
+	for _, tee := range tees {
+		go func() { sums <- iter.Sum[int](tee) }()
+	}
+
+	if first, second := <-sums, <-sums; first != 499500 || second != 499500 {
+		t.Errorf("expected both sums to be 499500 but got %d and %d", first, second)
+	}
+}
+
+func TestIterTeeCapacity(t *testing.T) {
+	tees := iter.TeeBounded(iter.Range(0, 10), 2, 2)
+	first, second := tees[0], tees[1]
+	first.Next()
+	first.Next()
+
+	// The buffer is full, so the first iterator must wait for the second one
+	next := make(chan option.Option[int])
+	go func() { next <- first.Next() }()
+
+	select {
+	case value := <-next:
+		t.Fatalf("expected `first.Next()` to block on a full buffer but got %v", value)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	if value := second.Next(); value.Unwrap() != 0 {
+		t.Errorf("expected the second iterator to yield 0 but got %v", value)
+	} else if value := <-next; value.Unwrap() != 2 {
+		t.Errorf("expected the first iterator to yield 2 once there is room but got %v", value)
+	}
+
+	// A stopped iterator no longer holds the others back
+	second.Stop()
+	ExpectValues[int](t, "Tee(first)", first, []int{3, 4, 5, 6, 7, 8, 9})
+	ExpectValues[int](t, "Tee(stopped)", second, nil)
+}
+
+func TestIterTeeStopWaiting(t *testing.T) {
+	tees := iter.TeeBounded(iter.Range(0, 10), 2, 1)
+	tees[0].Next()
+
+	next := make(chan option.Option[int])
+	go func() { next <- tees[0].Next() }()
+
+	time.Sleep(10 * time.Millisecond)
+	tees[0].Stop()
+
+	if value := <-next; value.IsSome() {
+		t.Errorf("expected a stopped iterator to stop waiting and return None but got %v", value)
+	}
+}
+
+func TestIterTeeCapacityPanic(t *testing.T) {
+	defer ShouldPanic(t)
+	iter.TeeBounded(iter.Range(0, 1), 2, 0)
+}
+
+func TestIterBuffered(t *testing.T) {
+	ExpectValues[int](t, "Buffered", iter.Buffered(iter.Range(0, 100), 8), sequence(100))
+
+	empty := collections.Vec[int]{}
+	ExpectValues[int](t, "Buffered(empty)", iter.Buffered[int](empty.Iter(), 0), nil)
+}
+
+func TestIterBufferedNegativeSize(t *testing.T) {
+	defer ShouldPanic(t)
+	iter.Buffered(iter.Range(0, 1), -1)
+}
+
+func TestIterBufferedStop(t *testing.T) {
+	buffered := iter.Buffered(iter.Repeat(1), 1)
+	if value := buffered.Next(); value.Unwrap() != 1 {
+		t.Errorf("expected the first element to be 1 but got %v", value)
+	}
+
+	buffered.Stop()
+	buffered.Stop()
+
+	if buffered.Next().IsSome() {
+		t.Error("expected a stopped iterator to return None")
+	}
+}
+
+func TestIterWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	it := iter.WithContext(ctx, iter.Range(0, 10))
+
+	ExpectValues[int](t, "WithContext", iter.Take[int](it, 3), []int{0, 1, 2})
+	cancel()
+
+	if it.Next().IsSome() {
+		t.Error("expected a cancelled iterator to return None")
+	} else if err := it.Err(); !err.IsErrWith(func(err *error) bool { return errors.Is(*err, context.Canceled) }) {
+		t.Errorf("expected `Err` to be context.Canceled but got %v", err)
+	}
+
+	exhausted := iter.WithContext(context.Background(), iter.Range(0, 3))
+	ExpectValues[int](t, "WithContext(exhausted)", exhausted, []int{0, 1, 2})
+	if exhausted.Err().IsErr() {
+		t.Error("expected `Err` of an exhausted iterator to be Ok")
+	}
+}
+
+func TestIterFromChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	close(ch)
+
+	ExpectValues[int](t, "FromChan", iter.FromChan(context.Background(), ch), []int{1, 2})
+
+	// Nothing is ever sent, the iterator must stop waiting at the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	it := iter.FromChan(ctx, make(chan int))
+	if it.Next().IsSome() {
+		t.Error("expected `FromChan` to return None after the deadline")
+	} else if err := it.Err(); !err.IsErrWith(func(err *error) bool { return errors.Is(*err, context.DeadlineExceeded) }) {
+		t.Errorf("expected `Err` to be context.DeadlineExceeded but got %v", err)
+	}
+}
+
+func TestIterFromFnContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+
+	it := iter.FromFnContext(ctx, func(ctx context.Context) option.Option[int] {
+		count++
+		if count == 3 {
+			cancel()
+		}
+
+		return option.Some(count)
+	})
+
+	ExpectValues[int](t, "FromFnContext", it, []int{1, 2, 3})
+	if it.Err().IsOk() {
+		t.Error("expected `Err` to report the cancellation")
+	}
+}
+
+func TestIterFlattenOption(t *testing.T) {
+	options := collections.Vec[option.Option[int]]{option.Some(1), option.None[int](), option.Some(3)}
+	ExpectValues[int](t, "FlattenOption", iter.FlattenOption[int](options.Iter()), []int{1, 3})
+
+	// An option is an iterator on its own, so it can be chained with other iterators
+	vec := collections.Vec[int]{1, 2}
+	ExpectValues[int](t, "Chain(Some)", iter.Chain[int](vec.Iter(), option.Some(3).Iter()), []int{1, 2, 3})
+}
+
+func TestIterFlattenResult(t *testing.T) {
+	words := collections.Vec[string]{"1", "two", "3"}
+	parsed := iter.Map[string](words.Iter(), parseResult)
+	ExpectValues[int](t, "FlattenResult", iter.FlattenResult[int, TestError](parsed), []int{1, 3})
+}
@@ -0,0 +1,236 @@
+package tests
+
+import (
+	"context"
+	"maps"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+)
+
+func TestIteratorEarlyStop(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4, 5}
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 100; i++ {
+		iter := vec.Iter()
+		if iter.Next().Unwrap() != 1 {
+			t.Fatal("expected `iter.Next()` to be `Some(1)`")
+		}
+	}
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("expected no goroutines to be leaked but got %d new", after-before)
+	}
+}
+
+func TestIteratorExhausted(t *testing.T) {
+	vec := collections.Vec[int]{1}
+	iter := vec.Iter()
+
+	if iter.Next().Unwrap() != 1 {
+		t.Error("expected 1st `iter.Next()` to be `Some(1)`")
+	} else if iter.Next().IsSome() {
+		t.Error("expected 2nd `iter.Next()` to be `None`")
+	} else if iter.Next().IsSome() {
+		t.Error("expected 3rd `iter.Next()` to be `None`")
+	}
+}
+
+func TestIteratorIntoChan(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	expectedValues := []int{1, 2, 3}
+
+	index := 0
+	for value := range collections.IntoChan[int](vec.Iter()) {
+		if value != expectedValues[index] {
+			t.Errorf("expected `value` to be %d but got %d", expectedValues[index], value)
+		}
+
+		index++
+	}
+
+	if index != len(expectedValues) {
+		t.Errorf("expected `index` to be %d but got %d", len(expectedValues), index)
+	}
+}
+
+func TestIteratorIntoVector(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	other := collections.IntoVector[int](vec.Iter())
+
+	if other.Len() != vec.Len() {
+		t.Errorf("expected `other.Len()` to be %d but got %d", vec.Len(), other.Len())
+	}
+
+	for i, v := range *other {
+		if v != vec[i] {
+			t.Errorf("expected `other[%d]` to be %d but got %d", i, vec[i], v)
+		}
+	}
+}
+
+func TestIteratorCollect(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 2, 3}
+
+	collected := collections.Collect[collections.Vec[int], *collections.Vec[int], int](vec.Iter())
+	ExpectValues[int](t, "collections.Collect", collected.Iter(), []int{1, 2, 2, 3})
+
+	other := collections.CollectVec[int](vec.Iter())
+	ExpectValues[int](t, "collections.CollectVec", other.Iter(), []int{1, 2, 2, 3})
+
+	set := collections.CollectSet[int](vec.Iter())
+	if set.Len() != 3 || !set.Contains(1) || !set.Contains(2) || !set.Contains(3) {
+		t.Errorf("expected `collections.CollectSet` to be {1, 2, 3} but got %v", set)
+	}
+}
+
+func TestIteratorCollectMap(t *testing.T) {
+	pairs := collections.Vec[collections.Pair[string, int]]{{First: "a", Second: 1}, {First: "b", Second: 2}}
+	m := collections.CollectMap[string, int](pairs.Iter())
+
+	if len(m) != 2 || m["a"] != 1 || m["b"] != 2 {
+		t.Errorf("expected `collections.CollectMap` to be {a: 1, b: 2} but got %v", m)
+	}
+}
+
+func TestIteratorCollectString(t *testing.T) {
+	runes := collections.Vec[rune]{'a', 'b', 'c'}
+	if s := collections.CollectString[rune](runes.Iter()); s != "abc" {
+		t.Errorf("expected `collections.CollectString` to be \"abc\" but got %q", s)
+	}
+
+	words := collections.Vec[string]{"foo", "bar"}
+	if s := collections.CollectString[string](words.Iter()); s != "foobar" {
+		t.Errorf("expected `collections.CollectString` to be \"foobar\" but got %q", s)
+	}
+}
+
+func TestIteratorNextBack(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+	values := vec.Iter()
+
+	if values.Len() != 4 {
+		t.Errorf("expected `values.Len()` to be 4 but got %d", values.Len())
+	} else if values.NextBack().Unwrap() != 4 {
+		t.Error("expected 1st `values.NextBack()` to be `Some(4)`")
+	} else if values.Next().Unwrap() != 1 {
+		t.Error("expected 1st `values.Next()` to be `Some(1)`")
+	} else if values.NextBack().Unwrap() != 3 {
+		t.Error("expected 2nd `values.NextBack()` to be `Some(3)`")
+	} else if values.Len() != 1 {
+		t.Errorf("expected `values.Len()` to be 1 but got %d", values.Len())
+	} else if values.Next().Unwrap() != 2 {
+		t.Error("expected 2nd `values.Next()` to be `Some(2)`")
+	} else if values.NextBack().IsSome() || values.Next().IsSome() {
+		t.Error("expected the iterator to be exhausted")
+	}
+}
+
+func TestIteratorSizeHint(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	values := vec.Iter()
+	values.Next()
+
+	if lower, upper := collections.SizeHint[int](values); lower != 2 || upper.Unwrap() != 2 {
+		t.Errorf("expected `collections.SizeHint(values)` to be (2, Some(2)) but got (%d, %v)", lower, upper)
+	}
+
+	if lower, upper := collections.SizeHint[int](&flickeringIter{}); lower != 0 || upper.IsSome() {
+		t.Errorf("expected the default size hint to be (0, None) but got (%d, %v)", lower, upper)
+	}
+}
+
+func TestIteratorExtendReservesCapacity(t *testing.T) {
+	vec := collections.Vec[int]{}
+	other := collections.Vec[int]{1, 2, 3, 4, 5}
+	vec.Extend(other.Iter())
+
+	if vec.Capacity() != other.Len() {
+		t.Errorf("expected `vec.Capacity()` to be %d but got %d", other.Len(), vec.Capacity())
+	}
+}
+
+func TestIteratorAll(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3}
+	if values := slices.Collect(vec.All()); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Errorf("expected `vec.All()` to yield [1 2 3] but got %v", values)
+	}
+
+	m := collections.Map[string, int]{"a": 1, "b": 2}
+	if collected := maps.Collect(m.All()); !maps.Equal(collected, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("expected `m.All()` to yield {a: 1, b: 2} but got %v", collected)
+	}
+
+	set := collections.Set[int]{1: {}, 2: {}}
+	if values := slices.Sorted(set.All()); !slices.Equal(values, []int{1, 2}) {
+		t.Errorf("expected `set.All()` to yield [1 2] but got %v", values)
+	}
+}
+
+func TestIteratorToSeq(t *testing.T) {
+	vec := collections.Vec[int]{1, 2, 3, 4}
+	values := vec.Iter()
+
+	for value := range collections.ToSeq(values) {
+		if value == 2 {
+			break
+		}
+	}
+
+	if values.Next().Unwrap() != 3 {
+		t.Error("expected breaking out of `collections.ToSeq` to stop pulling values")
+	}
+
+	pairs := collections.Vec[collections.Pair[string, int]]{{First: "a", Second: 1}, {First: "b", Second: 2}}
+	if collected := maps.Collect(collections.ToSeq2(pairs.Iter())); !maps.Equal(collected, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("expected `collections.ToSeq2` to yield {a: 1, b: 2} but got %v", collected)
+	}
+}
+
+func TestIteratorFromSeq(t *testing.T) {
+	ExpectValues(t, "collections.FromSeq", collections.FromSeq(slices.Values([]int{1, 2, 3})), []int{1, 2, 3})
+
+	pairs := collections.FromSeq2(slices.All([]string{"a", "b"}))
+	expectedPairs := []collections.Pair[int, string]{{First: 0, Second: "a"}, {First: 1, Second: "b"}}
+	ExpectValues(t, "collections.FromSeq2", pairs, expectedPairs)
+}
+
+func TestIteratorFromSeqStop(t *testing.T) {
+	stopped := false
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; yield(i); i++ {
+		}
+	}
+
+	values := collections.FromSeq[int](seq)
+	if values.Next().Unwrap() != 0 || values.Next().Unwrap() != 1 {
+		t.Error("expected `collections.FromSeq` to yield 0 and 1")
+	}
+
+	values.Stop()
+	if !stopped {
+		t.Error("expected `values.Stop()` to stop the underlying sequence")
+	} else if values.Next().IsSome() {
+		t.Error("expected `values.Next()` to be `None` after `values.Stop()`")
+	}
+}
+
+func TestIteratorIntoChanContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	vec := collections.Vec[int]{1, 2, 3}
+	ch := collections.IntoChanContext[int](ctx, vec.Iter())
+
+	if value := <-ch; value != 1 {
+		t.Errorf("expected the first value to be 1 but got %d", value)
+	}
+
+	cancel()
+
+	// The goroutine must close the channel even though the iterator is not exhausted yet
+	for range ch {
+	}
+}
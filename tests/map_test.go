@@ -59,20 +59,22 @@ func TestMapDrain(t *testing.T) {
 		t.Error("expected `len(m)` to be 3")
 	}
 
-	values := [][]int{
-		{1, 5},
-		{2, 6},
-		{3, 7},
-	}
-
-	index := 0
+	// Cannot predict order so just check if it exists
+	expectedPairs := map[int]int{1: 5, 2: 6, 3: 7}
+	found := make(map[int]bool)
 
-	for pair := range m.Drain() {
-		if pair.First != values[index][0] || pair.Second != values[index][1] {
-			t.Errorf("Expected pair to be (%d, %d) but got (%d, %d)", values[index][0], values[index][1], pair.First, pair.Second)
+	iter := m.Drain()
+	for pair := iter.Next(); pair.IsSome(); pair = iter.Next() {
+		key, value := pair.Unwrap().First, pair.Unwrap().Second
+		if expectedPairs[key] != value {
+			t.Errorf("Expected pair to be (%d, %d) but got (%d, %d)", key, expectedPairs[key], key, value)
 		}
 
-		index += 1
+		found[key] = true
+	}
+
+	if len(found) != len(expectedPairs) {
+		t.Errorf("Expected %d pairs to be drained but got %d", len(expectedPairs), len(found))
 	}
 
 	if len(m) != 0 {
@@ -166,7 +168,9 @@ func TestMapKeysValuesAndPairs(t *testing.T) {
 	expectedKeys[2] = false
 	expectedKeys[3] = false
 
-	for key := range m.Keys() {
+	keys := m.Keys()
+	for key := keys.Next(); key.IsSome(); key = keys.Next() {
+		key := key.Unwrap()
 		if _, ok := expectedKeys[key]; !ok {
 			t.Errorf("Expected key to be in expectedKeys but got %d", key)
 		}
@@ -187,7 +191,9 @@ func TestMapKeysValuesAndPairs(t *testing.T) {
 	expectedValues[6] = false
 	expectedValues[7] = false
 
-	for val := range m.Values() {
+	values := m.Values()
+	for val := values.Next(); val.IsSome(); val = values.Next() {
+		val := val.Unwrap()
 		if _, ok := expectedValues[val]; !ok {
 			t.Errorf("Expected key to be in expectedValues but got %d", val)
 		}
@@ -209,7 +215,9 @@ func TestMapKeysValuesAndPairs(t *testing.T) {
 	expectedPairs[2] = 6
 	expectedPairs[3] = 7
 
-	for pair := range m.Iter() {
+	pairs := m.Iter()
+	for pair := pairs.Next(); pair.IsSome(); pair = pairs.Next() {
+		pair := pair.Unwrap()
 		if _, ok := expectedPairs[pair.First]; !ok {
 			t.Errorf("Expected key %d to be in expectedPairs", pair.First)
 		} else if expectedPairs[pair.First] != pair.Second {
@@ -281,9 +289,12 @@ func TestMapItereator(t *testing.T) {
 
 	index := 0
 
-	for pair := range m.Iter() {
-		if pair.First != values[index][0] || pair.Second != values[index][1] {
-			t.Errorf("Expected pair to be (%d, %d) but got (%d, %d)", values[index][0], values[index][1], pair.First, pair.Second)
+	// Cannot predict order so just check the value matches the key
+	iter := m.Iter()
+	for pair := iter.Next(); pair.IsSome(); pair = iter.Next() {
+		pair := pair.Unwrap()
+		if m[pair.First] != pair.Second {
+			t.Errorf("Expected pair to be (%d, %d) but got (%d, %d)", pair.First, m[pair.First], pair.First, pair.Second)
 		}
 
 		index++
@@ -299,7 +310,8 @@ func TestMapEmptyIterator(t *testing.T) {
 
 	index := 0
 
-	for range m.Iter() {
+	iter := m.Iter()
+	for pair := iter.Next(); pair.IsSome(); pair = iter.Next() {
 		index++
 	}
 
@@ -307,3 +319,15 @@ func TestMapEmptyIterator(t *testing.T) {
 		t.Errorf("Expected index to be 0 but got %d", index)
 	}
 }
+
+func TestMapExtend(t *testing.T) {
+	var m collections.Map[int, int]
+	pairs := collections.Vec[collections.Pair[int, int]]{{First: 1, Second: 5}, {First: 2, Second: 6}, {First: 1, Second: 7}}
+	m.Extend(pairs.Iter())
+
+	if len(m) != 2 {
+		t.Errorf("expected `len(m)` to be 2 but got %d", len(m))
+	} else if m[1] != 7 || m[2] != 6 {
+		t.Errorf("expected `m` to be {1: 7, 2: 6} but got %v", m)
+	}
+}
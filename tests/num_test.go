@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/avivatedgi/go-rust-std/num"
+)
+
+func TestNumCmp(t *testing.T) {
+	if num.Cmp(1, 2) != num.Less {
+		t.Error("expected `num.Cmp(1, 2)` to be Less")
+	} else if num.Cmp("b", "a") != num.Greater {
+		t.Error("expected `num.Cmp(\"b\", \"a\")` to be Greater")
+	} else if num.Cmp(1.5, 1.5) != num.Equal {
+		t.Error("expected `num.Cmp(1.5, 1.5)` to be Equal")
+	} else if num.OrderingOf(-42) != num.Less || num.OrderingOf(42) != num.Greater {
+		t.Error("expected `num.OrderingOf` to keep only the sign of the comparison")
+	}
+}
+
+func TestNumOrdering(t *testing.T) {
+	if !num.Less.IsLt() || !num.Less.IsLe() || !num.Less.IsNe() || num.Less.IsGe() {
+		t.Error("expected Less to only be lt, le and ne")
+	} else if !num.Equal.IsEq() || !num.Equal.IsLe() || !num.Equal.IsGe() || num.Equal.IsNe() {
+		t.Error("expected Equal to only be eq, le and ge")
+	} else if !num.Greater.IsGt() || !num.Greater.IsGe() || num.Greater.IsLe() {
+		t.Error("expected Greater to only be gt, ge and ne")
+	}
+
+	if num.Less.Reverse() != num.Greater || num.Equal.Reverse() != num.Equal {
+		t.Error("expected `Reverse` to swap Less and Greater")
+	} else if num.Equal.Then(num.Less) != num.Less || num.Greater.Then(num.Less) != num.Greater {
+		t.Error("expected `Then` to only use the other ordering on Equal")
+	} else if num.Less.String() != "Less" || num.Ordering(5).String() != "Ordering(invalid)" {
+		t.Errorf("expected `String` to name the ordering but got %q", num.Less.String())
+	}
+}
+
+func TestNumCompareNaN(t *testing.T) {
+	if num.Compare(math.NaN(), math.Inf(-1)) != -1 {
+		t.Error("expected NaN to be less than any other value")
+	} else if num.Compare(math.NaN(), math.NaN()) != 0 {
+		t.Error("expected NaN to be equal to NaN")
+	}
+}
@@ -0,0 +1,212 @@
+package tests
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/avivatedgi/go-rust-std/option"
+)
+
+// An in-memory database/sql driver, holding a single table per DSN.
+// It only understands two kinds of queries: "INSERT" appends the arguments as a row, and "SELECT" returns all of the rows.
+type fakeDriver struct {
+	mutex  sync.Mutex
+	tables map[string][][]driver.Value
+}
+
+var fakeSQL = &fakeDriver{tables: map[string][][]driver.Value{}}
+
+func init() {
+	sql.Register("fake", fakeSQL)
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+	dsn    string
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+}
+
+func (d *fakeDriver) Open(dsn string) (driver.Conn, error) {
+	return &fakeConn{driver: d, dsn: dsn}, nil
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("transactions are not supported")
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if !strings.HasPrefix(s.query, "INSERT") {
+		return nil, fmt.Errorf("unsupported query %q", s.query)
+	}
+
+	s.conn.driver.mutex.Lock()
+	defer s.conn.driver.mutex.Unlock()
+
+	s.conn.driver.tables[s.conn.dsn] = append(s.conn.driver.tables[s.conn.dsn], args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if !strings.HasPrefix(s.query, "SELECT") {
+		return nil, fmt.Errorf("unsupported query %q", s.query)
+	}
+
+	s.conn.driver.mutex.Lock()
+	defer s.conn.driver.mutex.Unlock()
+
+	return &fakeRows{rows: append([][]driver.Value(nil), s.conn.driver.tables[s.conn.dsn]...)}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+
+	columns := make([]string, len(r.rows[0]))
+	for i := range columns {
+		columns[i] = fmt.Sprintf("column%d", i)
+	}
+
+	return columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+// A type with its own scanner and valuer, stored as a string in the database.
+type sqlPoint struct {
+	X, Y int
+}
+
+func (p *sqlPoint) Scan(src any) error {
+	text, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into a point", src)
+	}
+
+	_, err := fmt.Sscanf(text, "%d,%d", &p.X, &p.Y)
+	return err
+}
+
+func (p sqlPoint) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d,%d", p.X, p.Y), nil
+}
+
+func openFakeDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("fake", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestOptionValue(t *testing.T) {
+	if value, err := option.None[string]().Value(); value != nil || err != nil {
+		t.Errorf("expected `None.Value()` to be NULL but got (%v, %v)", value, err)
+	} else if value, err := option.Some[int32](1).Value(); value != int64(1) || err != nil {
+		t.Errorf("expected `Some(1).Value()` to be int64(1) but got (%v, %v)", value, err)
+	} else if value, err := option.Some(sqlPoint{X: 1, Y: 2}).Value(); value != "1,2" || err != nil {
+		t.Errorf("expected `Some(point).Value()` to use the valuer of the point but got (%v, %v)", value, err)
+	}
+}
+
+func TestOptionSQL(t *testing.T) {
+	db := openFakeDB(t)
+
+	insert := "INSERT INTO users VALUES (?, ?, ?)"
+	if _, err := db.Exec(insert, option.Some("a"), option.Some[int64](1), option.Some(sqlPoint{X: 1, Y: 2})); err != nil {
+		t.Fatal(err)
+	} else if _, err := db.Exec(insert, option.None[string](), option.None[int64](), option.None[sqlPoint]()); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query("SELECT * FROM users")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer rows.Close()
+
+	var (
+		names  []option.Option[string]
+		ages   []option.Option[int64]
+		points []option.Option[sqlPoint]
+	)
+
+	for rows.Next() {
+		var (
+			name  option.Option[string]
+			age   option.Option[int64]
+			point option.Option[sqlPoint]
+		)
+
+		if err := rows.Scan(&name, &age, &point); err != nil {
+			t.Fatal(err)
+		}
+
+		names, ages, points = append(names, name), append(ages, age), append(points, point)
+	}
+
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	} else if len(names) != 2 {
+		t.Fatalf("expected 2 rows but got %d", len(names))
+	}
+
+	if names[0].Unwrap() != "a" || ages[0].Unwrap() != 1 || points[0].Unwrap() != (sqlPoint{X: 1, Y: 2}) {
+		t.Errorf("expected the first row to be Some but got (%v, %v, %v)", names[0], ages[0], points[0])
+	} else if names[1].IsSome() || ages[1].IsSome() || points[1].IsSome() {
+		t.Errorf("expected the second row to be None but got (%v, %v, %v)", names[1], ages[1], points[1])
+	}
+}
+
+func TestOptionScanError(t *testing.T) {
+	var value option.Option[int]
+	if err := value.Scan("not a number"); err == nil {
+		t.Error("expected `Scan` to fail on a value that can't be converted")
+	} else if value.IsSome() {
+		t.Error("expected a failed `Scan` to leave the option unchanged")
+	}
+}
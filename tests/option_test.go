@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/avivatedgi/go-rust-std/option"
@@ -143,3 +144,291 @@ func TestOptionMapOrElse(t *testing.T) {
 		t.Error("expected `option.MapOrElse` to be 5")
 	}
 }
+
+func TestOptionIter(t *testing.T) {
+	some := option.Some(1).Iter()
+	if some.Len() != 1 {
+		t.Errorf("expected `Some(1).Iter()` to have 1 element but got %d", some.Len())
+	}
+
+	ExpectValues[int](t, "Some(1).Iter()", some, []int{1})
+	ExpectValues[int](t, "None.Iter()", option.None[int]().Iter(), nil)
+
+	if option.Some(1).Iter().NextBack().Unwrap() != 1 {
+		t.Error("expected `Some(1).Iter().NextBack()` to be Some(1)")
+	}
+}
+
+func TestOptionIsNoneOr(t *testing.T) {
+	positive := func(value *int) bool { return *value > 0 }
+
+	if !option.None[int]().IsNoneOr(positive) || !option.Some(1).IsNoneOr(positive) {
+		t.Error("expected `option.IsNoneOr` to be true")
+	} else if option.Some(-1).IsNoneOr(positive) {
+		t.Error("expected `option.IsNoneOr` to be false")
+	}
+}
+
+func TestOptionInspect(t *testing.T) {
+	inspected := 0
+	inspect := func(value *int) { inspected += *value }
+
+	if option.Some(2).Inspect(inspect).Unwrap() != 2 || option.None[int]().Inspect(inspect).IsSome() {
+		t.Error("expected `option.Inspect` to return the option unchanged")
+	} else if inspected != 2 {
+		t.Errorf("expected `option.Inspect` to be called once with 2 but got %d", inspected)
+	}
+}
+
+func TestOptionFilter(t *testing.T) {
+	even := func(value *int) bool { return *value%2 == 0 }
+
+	if option.Some(2).Filter(even).Unwrap() != 2 {
+		t.Error("expected `option.Filter` to be Some(2)")
+	} else if option.Some(3).Filter(even).IsSome() || option.None[int]().Filter(even).IsSome() {
+		t.Error("expected `option.Filter` to be None")
+	}
+}
+
+func TestOptionOr(t *testing.T) {
+	if option.Some(1).Or(option.Some(2)).Unwrap() != 1 {
+		t.Error("expected `option.Or` to be Some(1)")
+	} else if option.None[int]().Or(option.Some(2)).Unwrap() != 2 {
+		t.Error("expected `option.Or` to be Some(2)")
+	} else if option.None[int]().Or(option.None[int]()).IsSome() {
+		t.Error("expected `option.Or` to be None")
+	}
+}
+
+func TestOptionOrElse(t *testing.T) {
+	called := false
+	other := func() option.Option[int] {
+		called = true
+		return option.Some(2)
+	}
+
+	if option.Some(1).OrElse(other).Unwrap() != 1 || called {
+		t.Error("expected `option.OrElse` to be Some(1) without calling the closure")
+	} else if option.None[int]().OrElse(other).Unwrap() != 2 {
+		t.Error("expected `option.OrElse` to be Some(2)")
+	}
+}
+
+func TestOptionXor(t *testing.T) {
+	if option.Some(1).Xor(option.None[int]()).Unwrap() != 1 {
+		t.Error("expected `option.Xor` to be Some(1)")
+	} else if option.None[int]().Xor(option.Some(2)).Unwrap() != 2 {
+		t.Error("expected `option.Xor` to be Some(2)")
+	} else if option.Some(1).Xor(option.Some(2)).IsSome() || option.None[int]().Xor(option.None[int]()).IsSome() {
+		t.Error("expected `option.Xor` to be None")
+	}
+}
+
+func TestOptionAnd(t *testing.T) {
+	if option.And(option.Some(1), option.Some("a")).Unwrap() != "a" {
+		t.Error("expected `option.And` to be Some(\"a\")")
+	} else if option.And(option.None[int](), option.Some("a")).IsSome() || option.And(option.Some(1), option.None[string]()).IsSome() {
+		t.Error("expected `option.And` to be None")
+	}
+}
+
+func TestOptionAndThen(t *testing.T) {
+	half := func(value *int) option.Option[int] {
+		if *value%2 != 0 {
+			return option.None[int]()
+		}
+
+		return option.Some(*value / 2)
+	}
+
+	if option.AndThen(option.Some(4), half).Unwrap() != 2 {
+		t.Error("expected `option.AndThen` to be Some(2)")
+	} else if option.AndThen(option.Some(3), half).IsSome() || option.AndThen(option.None[int](), half).IsSome() {
+		t.Error("expected `option.AndThen` to be None")
+	}
+}
+
+func TestOptionZip(t *testing.T) {
+	if pair := option.Zip(option.Some(1), option.Some("a")).Unwrap(); pair.First != 1 || pair.Second != "a" {
+		t.Errorf("expected `option.Zip` to be Some({1 a}) but got %v", pair)
+	} else if option.Zip(option.Some(1), option.None[string]()).IsSome() {
+		t.Error("expected `option.Zip` to be None")
+	}
+
+	add := func(a *int, b *int) int { return *a + *b }
+	if option.ZipWith(option.Some(1), option.Some(2), add).Unwrap() != 3 {
+		t.Error("expected `option.ZipWith` to be Some(3)")
+	} else if option.ZipWith(option.None[int](), option.Some(2), add).IsSome() {
+		t.Error("expected `option.ZipWith` to be None")
+	}
+}
+
+func TestOptionUnzip(t *testing.T) {
+	if first, second := option.Unzip(option.Some(option.Pair[int, string]{First: 1, Second: "a"})); first.Unwrap() != 1 || second.Unwrap() != "a" {
+		t.Errorf("expected `option.Unzip` to be (Some(1), Some(\"a\")) but got (%v, %v)", first, second)
+	} else if first, second := option.Unzip(option.None[option.Pair[int, string]]()); first.IsSome() || second.IsSome() {
+		t.Error("expected `option.Unzip` to be (None, None)")
+	}
+}
+
+func TestOptionFlatten(t *testing.T) {
+	if option.Flatten(option.Some(option.Some(1))).Unwrap() != 1 {
+		t.Error("expected `option.Flatten` to be Some(1)")
+	} else if option.Flatten(option.Some(option.None[int]())).IsSome() || option.Flatten(option.None[option.Option[int]]()).IsSome() {
+		t.Error("expected `option.Flatten` to be None")
+	}
+}
+
+func TestOptionTake(t *testing.T) {
+	some := option.Some(1)
+	if some.Take().Unwrap() != 1 || some.IsSome() {
+		t.Error("expected `option.Take` to return Some(1) and leave None")
+	}
+
+	none := option.None[int]()
+	if none.Take().IsSome() || none.IsSome() {
+		t.Error("expected `option.Take` of None to be None")
+	}
+}
+
+func TestOptionTakeIf(t *testing.T) {
+	even := func(value *int) bool { return *value%2 == 0 }
+
+	odd := option.Some(1)
+	if odd.TakeIf(even).IsSome() || odd.Unwrap() != 1 {
+		t.Error("expected `option.TakeIf` to leave Some(1) unchanged")
+	}
+
+	two := option.Some(2)
+	if two.TakeIf(even).Unwrap() != 2 || two.IsSome() {
+		t.Error("expected `option.TakeIf` to take Some(2)")
+	}
+}
+
+func TestOptionReplace(t *testing.T) {
+	value := option.Some(1)
+	if value.Replace(2).Unwrap() != 1 || value.Unwrap() != 2 {
+		t.Error("expected `option.Replace` to return Some(1) and leave Some(2)")
+	}
+
+	none := option.None[int]()
+	if none.Replace(3).IsSome() || none.Unwrap() != 3 {
+		t.Error("expected `option.Replace` of None to return None and leave Some(3)")
+	}
+}
+
+func TestOptionInsert(t *testing.T) {
+	value := option.Some(1)
+	ptr := value.Insert(2)
+	*ptr = 3
+
+	if value.Unwrap() != 3 {
+		t.Errorf("expected `option.Insert` to return a pointer to the value but got %v", value)
+	}
+}
+
+func TestOptionGetOrInsert(t *testing.T) {
+	none := option.None[int]()
+	if *none.GetOrInsert(1) != 1 || none.Unwrap() != 1 {
+		t.Error("expected `option.GetOrInsert` to insert 1")
+	} else if *none.GetOrInsert(2) != 1 {
+		t.Error("expected `option.GetOrInsert` to keep 1")
+	}
+
+	calls := 0
+	lazy := option.None[int]()
+	insert := func() int {
+		calls++
+		return 5
+	}
+
+	*lazy.GetOrInsertWith(insert)++
+	if *lazy.GetOrInsertWith(insert) != 6 || calls != 1 {
+		t.Errorf("expected `option.GetOrInsertWith` to insert once and return a pointer but got %v", lazy)
+	}
+}
+
+func TestOptionPtr(t *testing.T) {
+	if option.None[int]().AsPtr() != nil {
+		t.Error("expected `option.AsPtr` of None to be nil")
+	} else if *option.Some(1).AsPtr() != 1 {
+		t.Error("expected `option.AsPtr` to point to 1")
+	}
+
+	value := 1
+	converted := option.FromPtr(&value)
+	value = 2
+
+	if converted.Unwrap() != 1 {
+		t.Error("expected `option.FromPtr` to copy the value")
+	} else if option.FromPtr[int](nil).IsSome() {
+		t.Error("expected `option.FromPtr(nil)` to be None")
+	}
+}
+
+type optionJSONExample struct {
+	Name  option.Option[string] `json:"name"`
+	Email option.Option[string] `json:"email,omitzero"`
+	Age   option.Option[int]    `json:"age"`
+}
+
+func TestOptionMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(optionJSONExample{Name: option.Some("a"), Email: option.None[string](), Age: option.None[int]()})
+	if err != nil {
+		t.Fatal(err)
+	} else if string(data) != `{"name":"a","age":null}` {
+		t.Errorf("expected `json.Marshal` to encode None as null but got %s", data)
+	}
+}
+
+func TestOptionUnmarshalJSON(t *testing.T) {
+	var example optionJSONExample
+	if err := json.Unmarshal([]byte(`{"name":"a","age":null}`), &example); err != nil {
+		t.Fatal(err)
+	}
+
+	if example.Name.Unwrap() != "a" {
+		t.Errorf("expected `name` to be Some(\"a\") but got %v", example.Name)
+	} else if example.Email.IsSome() || example.Age.IsSome() {
+		t.Error("expected missing and null fields to be None")
+	}
+
+	if err := json.Unmarshal([]byte(`{"age":"old"}`), &example); err == nil {
+		t.Error("expected `json.Unmarshal` to fail on a mismatched type")
+	}
+}
+
+type optionPatchExample struct {
+	Email option.Field[string] `json:"email,omitzero"`
+	Phone option.Field[string] `json:"phone,omitzero"`
+	Name  option.Field[string] `json:"name,omitzero"`
+}
+
+func TestOptionFieldJSON(t *testing.T) {
+	var patch optionPatchExample
+	if err := json.Unmarshal([]byte(`{"email":null,"name":"a"}`), &patch); err != nil {
+		t.Fatal(err)
+	}
+
+	if !patch.Email.IsPresent() || !patch.Email.IsNull() {
+		t.Error("expected an explicit null to be present and null")
+	} else if patch.Phone.IsPresent() || patch.Phone.IsSome() {
+		t.Error("expected a missing field to not be present")
+	} else if !patch.Name.IsPresent() || patch.Name.Unwrap() != "a" {
+		t.Errorf("expected `name` to be present with Some(\"a\") but got %v", patch.Name)
+	}
+
+	data, err := json.Marshal(optionPatchExample{Email: option.Present(option.None[string]()), Phone: option.Absent[string]()})
+	if err != nil {
+		t.Fatal(err)
+	} else if string(data) != `{"email":null}` {
+		t.Errorf("expected `json.Marshal` to only omit absent fields but got %s", data)
+	}
+
+	// Decoding and re-encoding a patch must keep missing fields missing
+	if data, err := json.Marshal(patch); err != nil {
+		t.Fatal(err)
+	} else if string(data) != `{"email":null,"name":"a"}` {
+		t.Errorf("expected the patch to round trip but got %s", data)
+	}
+}
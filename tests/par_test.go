@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+	"github.com/avivatedgi/go-rust-std/par"
+)
+
+// Returns a vector holding the numbers [0, n).
+func sequence(n int) collections.Vec[int] {
+	vec := make(collections.Vec[int], n)
+	for i := range vec {
+		vec[i] = i
+	}
+
+	return vec
+}
+
+func TestParCollectOrdered(t *testing.T) {
+	vec := sequence(1000)
+	squares := par.Map(par.FromVec(vec).WithWorkers(4).Ordered(), func(v int) int { return v * v })
+	collected := squares.Filter(func(v int) bool { return v%2 == 0 }).Collect().Unwrap()
+
+	expected := []int{}
+	for _, v := range vec {
+		if v*v%2 == 0 {
+			expected = append(expected, v*v)
+		}
+	}
+
+	if !slices.Equal(collected, expected) {
+		t.Errorf("expected `par.Collect` to keep the order of the elements")
+	}
+}
+
+func TestParCollectUnordered(t *testing.T) {
+	vec := sequence(1000)
+	collected := par.FromVec(vec).WithWorkers(8).Collect().Unwrap()
+
+	slices.Sort(collected)
+	if !slices.Equal(collected, vec) {
+		t.Errorf("expected `par.Collect` to yield all the elements")
+	}
+}
+
+func TestParForEach(t *testing.T) {
+	var sum atomic.Int64
+	res := par.FromVec(sequence(101)).ForEach(func(v int) { sum.Add(int64(v)) })
+
+	if res.IsErr() {
+		t.Errorf("expected `par.ForEach` to be Ok but got %v", res.UnwrapErr())
+	} else if sum.Load() != 5050 {
+		t.Errorf("expected the sum to be 5050 but got %d", sum.Load())
+	}
+}
+
+func TestParReduce(t *testing.T) {
+	words := collections.Vec[string]{"a", "b", "c", "d", "e", "f", "g"}
+
+	// Concatenation is associative but not commutative, so this checks the chunks are combined in order
+	joined := par.FromVec(words).WithWorkers(3).Reduce(func(a, b string) string { return a + b })
+	if joined.Unwrap().Unwrap() != "abcdefg" {
+		t.Errorf("expected `par.Reduce` to be \"abcdefg\" but got %q", joined.Unwrap().Unwrap())
+	}
+
+	empty := par.FromVec(collections.Vec[string]{}).Reduce(func(a, b string) string { return a + b })
+	if empty.Unwrap().IsSome() {
+		t.Error("expected `par.Reduce` on an empty iterator to be `None`")
+	}
+}
+
+func TestParReducePanic(t *testing.T) {
+	words := collections.Vec[string]{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p"}
+
+	// Chunks hold two words each, so only combining the results of the chunks gets longer arguments
+	res := par.FromVec(words).WithWorkers(2).Reduce(func(a, b string) string {
+		if len(a) > 1 && len(b) > 1 {
+			panic("boom")
+		}
+
+		return a + b
+	})
+
+	if res.IsOk() {
+		t.Fatal("expected `par.Reduce` to be Err")
+	} else if err := res.UnwrapErr(); err.Value != "boom" || len(err.Stack) == 0 {
+		t.Errorf("expected the error to hold the panic value and stack but got %v", err)
+	}
+}
+
+func TestParFromMap(t *testing.T) {
+	m := collections.Map[string, int]{"a": 1, "b": 2, "c": 3}
+	sum := par.Map(par.FromMap(m), func(pair collections.Pair[string, int]) int { return pair.Second }).Reduce(func(a, b int) int { return a + b })
+
+	if sum.Unwrap().Unwrap() != 6 {
+		t.Errorf("expected the sum to be 6 but got %d", sum.Unwrap().Unwrap())
+	}
+}
+
+func TestParPanic(t *testing.T) {
+	res := par.Map(par.FromVec(sequence(100)).WithWorkers(4), func(v int) int {
+		if v == 42 {
+			panic("boom")
+		}
+
+		return v
+	}).Collect()
+
+	if res.IsOk() {
+		t.Fatal("expected `par.Collect` to be Err")
+	} else if err := res.UnwrapErr(); err.Value != "boom" || len(err.Stack) == 0 {
+		t.Errorf("expected the error to hold the panic value and stack but got %v", err)
+	}
+}
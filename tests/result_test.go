@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/avivatedgi/go-rust-std/option"
 	"github.com/avivatedgi/go-rust-std/result"
 )
 
@@ -301,3 +302,58 @@ func TestResultOrElse(t *testing.T) {
 		t.Error("expected `result.OrElse(result.Ok[int, TestError](6), err)` to return 6")
 	}
 }
+
+func TestResultIter(t *testing.T) {
+	ExpectValues[int](t, "Ok(1).Iter()", result.Ok[int, TestError](1).Iter(), []int{1})
+	ExpectValues[int](t, "Err.Iter()", result.Err[int](TestError{Value: 1}).Iter(), nil)
+}
+
+func TestResultOkOr(t *testing.T) {
+	if result.OkOr(option.Some(1), TestError{Value: 2}).Unwrap() != 1 {
+		t.Error("expected `result.OkOr(Some(1), ...)` to return 1")
+	} else if result.OkOr(option.None[int](), TestError{Value: 2}).UnwrapErr().Value != 2 {
+		t.Error("expected `result.OkOr(None, ...)` to return TestError{2}")
+	}
+
+	called := false
+	f := func() TestError {
+		called = true
+		return TestError{Value: 3}
+	}
+
+	if result.OkOrElse(option.Some(1), f).Unwrap() != 1 || called {
+		t.Error("expected `result.OkOrElse(Some(1), f)` to return 1 without calling f")
+	} else if result.OkOrElse(option.None[int](), f).UnwrapErr().Value != 3 {
+		t.Error("expected `result.OkOrElse(None, f)` to return TestError{3}")
+	}
+}
+
+func TestResultTranspose(t *testing.T) {
+	if result.Transpose(result.Ok[option.Option[int], TestError](option.Some(1))).Unwrap().Unwrap() != 1 {
+		t.Error("expected `result.Transpose(Ok(Some(1)))` to return Some(Ok(1))")
+	} else if result.Transpose(result.Ok[option.Option[int], TestError](option.None[int]())).IsSome() {
+		t.Error("expected `result.Transpose(Ok(None))` to return None")
+	} else if result.Transpose(result.Err[option.Option[int]](TestError{Value: 1})).Unwrap().UnwrapErr().Value != 1 {
+		t.Error("expected `result.Transpose(Err(...))` to return Some(Err(TestError{1}))")
+	}
+}
+
+func TestResultTransposeOption(t *testing.T) {
+	if result.TransposeOption(option.Some(result.Ok[int, TestError](1))).Unwrap().Unwrap() != 1 {
+		t.Error("expected `result.TransposeOption(Some(Ok(1)))` to return Ok(Some(1))")
+	} else if result.TransposeOption(option.None[result.Result[int, TestError]]()).Unwrap().IsSome() {
+		t.Error("expected `result.TransposeOption(None)` to return Ok(None)")
+	} else if result.TransposeOption(option.Some(result.Err[int](TestError{Value: 1}))).UnwrapErr().Value != 1 {
+		t.Error("expected `result.TransposeOption(Some(Err(...)))` to return Err(TestError{1})")
+	}
+}
+
+func TestResultFlatten(t *testing.T) {
+	if result.Flatten(result.Ok[result.Result[int, TestError], TestError](result.Ok[int, TestError](1))).Unwrap() != 1 {
+		t.Error("expected `result.Flatten(Ok(Ok(1)))` to return Ok(1)")
+	} else if result.Flatten(result.Ok[result.Result[int, TestError], TestError](result.Err[int](TestError{Value: 1}))).UnwrapErr().Value != 1 {
+		t.Error("expected `result.Flatten(Ok(Err(...)))` to return Err(TestError{1})")
+	} else if result.Flatten(result.Err[result.Result[int, TestError]](TestError{Value: 2})).UnwrapErr().Value != 2 {
+		t.Error("expected `result.Flatten(Err(...))` to return Err(TestError{2})")
+	}
+}
@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/avivatedgi/go-rust-std/collections"
+)
+
+func TestSetInsertAndContains(t *testing.T) {
+	var set collections.Set[int]
+
+	if !set.Insert(1) {
+		t.Error("expected 1st `set.Insert(1)` to be true")
+	} else if set.Insert(1) {
+		t.Error("expected 2nd `set.Insert(1)` to be false")
+	} else if !set.Contains(1) {
+		t.Error("expected `set.Contains(1)` to be true")
+	} else if set.Contains(2) {
+		t.Error("expected `set.Contains(2)` to be false")
+	} else if set.Len() != 1 {
+		t.Errorf("expected `set.Len()` to be 1 but got %d", set.Len())
+	}
+}
+
+func TestSetRemoveAndClear(t *testing.T) {
+	set := collections.Set[int]{1: {}, 2: {}, 3: {}}
+
+	if !set.Remove(1) {
+		t.Error("expected 1st `set.Remove(1)` to be true")
+	} else if set.Remove(1) {
+		t.Error("expected 2nd `set.Remove(1)` to be false")
+	}
+
+	set.Clear()
+	if !set.IsEmpty() {
+		t.Errorf("expected `set` to be empty but got %v", set)
+	}
+}
+
+func TestSetExtendAndIter(t *testing.T) {
+	set := collections.Set[int]{}
+	vec := collections.Vec[int]{1, 2, 2, 3}
+	set.Extend(vec.Iter())
+
+	if set.Len() != 3 {
+		t.Errorf("expected `set.Len()` to be 3 but got %d", set.Len())
+	}
+
+	found := collections.Set[int]{}
+	values := set.Iter()
+	for value := values.Next(); value.IsSome(); value = values.Next() {
+		found.Insert(value.Unwrap())
+	}
+
+	if found.Len() != 3 || !found.Contains(1) || !found.Contains(2) || !found.Contains(3) {
+		t.Errorf("expected `set.Iter()` to yield 1, 2 and 3 but got %v", found)
+	}
+}
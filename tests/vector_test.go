@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"math"
 	"testing"
 
 	"github.com/avivatedgi/go-rust-std/collections"
@@ -88,7 +89,8 @@ func TestVectorDrain(t *testing.T) {
 
 	expectedValues := []int{2, 3}
 	counter := 0
-	for value := range iter {
+	for value := iter.Next(); value.IsSome(); value = iter.Next() {
+		value := value.Unwrap()
 		if value != expectedValues[counter] {
 			t.Errorf("expected `iter[%d]` to be %d but got %d", counter, expectedValues[counter], value)
 		}
@@ -100,7 +102,7 @@ func TestVectorDrain(t *testing.T) {
 func TestVectorExtend(t *testing.T) {
 	vec := collections.Vec[int]{1}
 	other := collections.Vec[int]{2, 3, 4}
-	vec.Extend(&other)
+	vec.Extend(other.Iter())
 
 	expectedValues := []int{1, 2, 3, 4}
 	for i, v := range vec {
@@ -333,7 +335,9 @@ func TestVectorIterator(t *testing.T) {
 
 	index := 0
 
-	for value := range vec.Iter() {
+	iter := vec.Iter()
+	for value := iter.Next(); value.IsSome(); value = iter.Next() {
+		value := value.Unwrap()
 		if value != slice[index] {
 			t.Errorf("expected `value` to be %d but got %d", slice[index], value)
 		}
@@ -345,3 +349,109 @@ func TestVectorIterator(t *testing.T) {
 		t.Errorf("expected `index` to be %d but got %d", len(slice), index)
 	}
 }
+
+func TestVectorIteratorLiteral(t *testing.T) {
+	// Iter has a value receiver, so it can be called on values that are not addressable
+	if count := (collections.Vec[int]{1, 2}).Iter().Len(); count != 2 {
+		t.Errorf("expected the iterator to have 2 elements but got %d", count)
+	}
+}
+
+func TestVectorSumProduct(t *testing.T) {
+	vec := collections.Vec[float64]{1.5, 2, 4}
+
+	if sum := collections.Sum(vec); sum != 7.5 {
+		t.Errorf("expected `collections.Sum(vec)` to be 7.5 but got %f", sum)
+	} else if product := collections.Product(vec); product != 12 {
+		t.Errorf("expected `collections.Product(vec)` to be 12 but got %f", product)
+	} else if collections.Product(collections.Vec[int]{}) != 1 {
+		t.Error("expected `collections.Product` on an empty vector to be 1")
+	}
+}
+
+func TestVectorMinMax(t *testing.T) {
+	vec := collections.Vec[int]{3, 1, 4, 1, 5}
+	empty := collections.Vec[int]{}
+
+	if collections.Min(vec).Unwrap() != 1 {
+		t.Error("expected `collections.Min(vec)` to be `Some(1)`")
+	} else if collections.Max(vec).Unwrap() != 5 {
+		t.Error("expected `collections.Max(vec)` to be `Some(5)`")
+	} else if collections.Min(empty).IsSome() || collections.Max(empty).IsSome() {
+		t.Error("expected `collections.Min` and `collections.Max` on an empty vector to be `None`")
+	}
+
+	pair := collections.MinMax(vec).Unwrap()
+	if pair.First != 1 || pair.Second != 5 {
+		t.Errorf("expected `collections.MinMax(vec)` to be (1, 5) but got (%d, %d)", pair.First, pair.Second)
+	} else if collections.MinMax(empty).IsSome() {
+		t.Error("expected `collections.MinMax` on an empty vector to be `None`")
+	}
+}
+
+func TestVectorMinMaxBy(t *testing.T) {
+	vec := collections.Vec[string]{"bb", "a", "cc", "d"}
+	byLength := func(a, b string) int { return len(a) - len(b) }
+	length := func(v string) int { return len(v) }
+
+	// On ties, Min returns the first element and Max returns the last one
+	if min := vec.MinBy(byLength).Unwrap(); min != "a" {
+		t.Errorf("expected `vec.MinBy` to be `Some(a)` but got `Some(%s)`", min)
+	} else if max := vec.MaxBy(byLength).Unwrap(); max != "cc" {
+		t.Errorf("expected `vec.MaxBy` to be `Some(cc)` but got `Some(%s)`", max)
+	} else if min := collections.MinByKey(vec, length).Unwrap(); min != "a" {
+		t.Errorf("expected `collections.MinByKey` to be `Some(a)` but got `Some(%s)`", min)
+	} else if max := collections.MaxByKey(vec, length).Unwrap(); max != "cc" {
+		t.Errorf("expected `collections.MaxByKey` to be `Some(cc)` but got `Some(%s)`", max)
+	}
+}
+
+func TestVectorMinMaxNaN(t *testing.T) {
+	vec := collections.Vec[float64]{1, math.NaN(), 0.5}
+	identity := func(v float64) float64 { return v }
+
+	// NaN is ordered before every other value, consistently across all of the aggregations
+	pair := collections.MinMax(vec).Unwrap()
+	if min := collections.Min(vec).Unwrap(); !math.IsNaN(min) {
+		t.Errorf("expected `collections.Min` to be `Some(NaN)` but got `Some(%f)`", min)
+	} else if max := collections.Max(vec).Unwrap(); max != 1 {
+		t.Errorf("expected `collections.Max` to be `Some(1)` but got `Some(%f)`", max)
+	} else if !math.IsNaN(pair.First) || pair.Second != 1 {
+		t.Errorf("expected `collections.MinMax` to be (NaN, 1) but got (%f, %f)", pair.First, pair.Second)
+	} else if min := collections.MinByKey(vec, identity).Unwrap(); !math.IsNaN(min) {
+		t.Errorf("expected `collections.MinByKey` to be `Some(NaN)` but got `Some(%f)`", min)
+	} else if max := collections.MaxByKey(vec, identity).Unwrap(); max != 1 {
+		t.Errorf("expected `collections.MaxByKey` to be `Some(1)` but got `Some(%f)`", max)
+	}
+}
+
+func TestVectorIsSorted(t *testing.T) {
+	sorted := collections.Vec[int]{1, 2, 2, 5}
+	unsorted := collections.Vec[int]{1, 3, 2}
+	descending := func(a, b int) int { return b - a }
+
+	if !collections.IsSorted(sorted) || !collections.IsSorted(collections.Vec[int]{}) {
+		t.Error("expected `collections.IsSorted` to be true")
+	} else if collections.IsSorted(unsorted) {
+		t.Error("expected `collections.IsSorted(unsorted)` to be false")
+	} else if !(collections.Vec[int]{3, 2, 1}).IsSortedBy(descending) || sorted.IsSortedBy(descending) {
+		t.Error("expected `vec.IsSortedBy(descending)` to only be true for descending vectors")
+	}
+
+	words := collections.Vec[string]{"c", "bb", "aaa"}
+	if !collections.IsSortedByKey(words, func(v string) int { return len(v) }) || collections.IsSorted(words) {
+		t.Error("expected `words` to be sorted by length but not lexicographically")
+	}
+
+	calls := 0
+	collections.IsSortedByKey(sorted, func(v int) int {
+		calls++
+		return v
+	})
+
+	if calls != sorted.Len() {
+		t.Errorf("expected the key function to be called %d times but got %d", sorted.Len(), calls)
+	} else if collections.IsSorted(collections.Vec[float64]{1, math.NaN(), 2}) || collections.IsSorted(collections.Vec[float64]{math.NaN(), 1}) {
+		t.Error("expected a vector containing NaN to not be sorted")
+	}
+}